@@ -0,0 +1,247 @@
+package calibration
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/model_selection"
+	"math"
+	"sort"
+)
+
+const (
+	EstimatorNil   = "Estimator is Nil"
+	UnknownMethod  = "Unknown Method"
+	InvalidFold    = "Invalid Fold"
+	UnequalPredict = "Unequal Predict Length"
+
+	DefaultFold = 5
+)
+
+// CalibratedClassifierConfig calibrates the probability of every class against the rest. The
+// calibrators learn from the out of fold probabilities of a StratifiedKFold shuffled with Seed,
+// then the estimator is refit on all the data.
+type CalibratedClassifierConfig[X any] struct {
+	Estimator classifier.Factory[X, string]
+	Method    string
+	Fold      int
+	Seed      int64
+}
+
+type CalibratedClassifier[X any] struct {
+	factory     classifier.Factory[X, string]
+	method      string
+	fold        int
+	seed        int64
+	estimator   classifier.Classifier[X, string]
+	classes     []string
+	calibrators map[string]Calibrator
+}
+
+func NewCalibratedClassifier[X any](cfg CalibratedClassifierConfig[X]) (*CalibratedClassifier[X], error) {
+	if cfg.Estimator == nil {
+		return nil, errors.New(EstimatorNil)
+	}
+
+	if cfg.Method == "" {
+		cfg.Method = Platt
+	}
+
+	if cfg.Method != Platt && cfg.Method != Isotonic {
+		return nil, errors.New(UnknownMethod)
+	}
+
+	if cfg.Fold == 0 {
+		cfg.Fold = DefaultFold
+	}
+
+	if cfg.Fold < 2 {
+		return nil, errors.New(InvalidFold)
+	}
+
+	return &CalibratedClassifier[X]{
+		factory: cfg.Estimator,
+		method:  cfg.Method,
+		fold:    cfg.Fold,
+		seed:    cfg.Seed,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*CalibratedClassifier[string])(nil)
+
+// getScore gives Platt scaling the log odds of the probability, so a sigmoid can undo the
+// over confidence of probabilities stuck close to zero or one.
+func (cc *CalibratedClassifier[X]) getScore(prob float64) float64 {
+	if cc.method == Isotonic {
+		return prob
+	}
+
+	prob = math.Min(math.Max(prob, 1e-15), 1-1e-15)
+
+	return math.Log(prob / (1 - prob))
+}
+
+func (cc *CalibratedClassifier[X]) Fit(inputs []X, targets []string) error {
+	if len(inputs) < cc.fold || len(inputs) != len(targets) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	exists := make(map[string]bool)
+	cc.classes = nil
+	for _, target := range targets {
+		if !exists[target] {
+			exists[target] = true
+			cc.classes = append(cc.classes, target)
+		}
+	}
+	sort.Strings(cc.classes)
+
+	folds, err := model_selection.Split(len(inputs), targets, model_selection.CrossValidationConfig{
+		Strategy: model_selection.StratifiedKFold,
+		Fold:     cc.fold,
+		Seed:     cc.seed,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	outOfFold := make([]map[string]float64, len(inputs))
+	for _, fold := range folds {
+		var trainInputs, testInputs []X
+		var trainTargets []string
+		for _, idx := range fold.Train {
+			trainInputs = append(trainInputs, inputs[idx])
+			trainTargets = append(trainTargets, targets[idx])
+		}
+		for _, idx := range fold.Test {
+			testInputs = append(testInputs, inputs[idx])
+		}
+
+		estimator, err := cc.factory()
+
+		if err != nil {
+			return err
+		}
+
+		err = estimator.Fit(trainInputs, trainTargets)
+
+		if err != nil {
+			return err
+		}
+
+		probabilities, err := estimator.PredictProbability(testInputs)
+
+		if err != nil {
+			return err
+		}
+
+		if len(probabilities) != len(testInputs) {
+			return errors.New(UnequalPredict)
+		}
+
+		for position, idx := range fold.Test {
+			outOfFold[idx] = probabilities[position]
+		}
+	}
+
+	cc.calibrators = make(map[string]Calibrator)
+	for _, corpusClass := range cc.classes {
+		scores := make([]float64, len(inputs))
+		classTargets := make([]bool, len(inputs))
+		for idx, prob := range outOfFold {
+			scores[idx] = cc.getScore(prob[corpusClass])
+			classTargets[idx] = targets[idx] == corpusClass
+		}
+
+		calibrator := NewCalibrator(cc.method)
+		err := calibrator.Fit(scores, classTargets)
+
+		if err != nil {
+			return err
+		}
+
+		cc.calibrators[corpusClass] = calibrator
+	}
+
+	estimator, err := cc.factory()
+
+	if err != nil {
+		return err
+	}
+
+	err = estimator.Fit(inputs, targets)
+
+	if err != nil {
+		return err
+	}
+
+	cc.estimator = estimator
+
+	return nil
+}
+
+// PredictProbability normalizes the calibrated probability of every class to sum to one.
+func (cc *CalibratedClassifier[X]) PredictProbability(inputs []X) ([]map[string]float64, error) {
+	if cc.estimator == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	probabilities, err := cc.estimator.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var allPrediction []map[string]float64
+	for _, prob := range probabilities {
+		calibrated := make(map[string]float64)
+		total := float64(0)
+		for _, corpusClass := range cc.classes {
+			calibrated[corpusClass] = cc.calibrators[corpusClass].Transform(cc.getScore(prob[corpusClass]))
+			total += calibrated[corpusClass]
+		}
+
+		for _, corpusClass := range cc.classes {
+			if total == 0 {
+				calibrated[corpusClass] = 1 / float64(len(cc.classes))
+			} else {
+				calibrated[corpusClass] /= total
+			}
+		}
+
+		allPrediction = append(allPrediction, calibrated)
+	}
+
+	return allPrediction, nil
+}
+
+func (cc *CalibratedClassifier[X]) Predict(inputs []X) ([]string, error) {
+	probabilities, err := cc.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range cc.classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (cc *CalibratedClassifier[X]) GetCalibrators() map[string]Calibrator {
+	return cc.calibrators
+}
+
+func (cc *CalibratedClassifier[X]) GetClasses() []string {
+	return cc.classes
+}
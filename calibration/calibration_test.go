@@ -0,0 +1,272 @@
+package calibration
+
+import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/metrics"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// overConfidentClassifier gives spam a probability of 0.99 above the threshold and 0.01 below.
+type overConfidentClassifier struct{}
+
+func (oc *overConfidentClassifier) Fit(inputs []float64, targets []string) error {
+	return nil
+}
+
+func (oc *overConfidentClassifier) PredictProbability(inputs []float64) ([]map[string]float64, error) {
+	var probabilities []map[string]float64
+	for _, input := range inputs {
+		spam := 0.01
+		if input > 0.5 {
+			spam = 0.99
+		}
+		probabilities = append(probabilities, map[string]float64{
+			"spam": spam,
+			"ham":  1 - spam,
+		})
+	}
+	return probabilities, nil
+}
+
+func (oc *overConfidentClassifier) Predict(inputs []float64) ([]string, error) {
+	var predicted []string
+	for _, input := range inputs {
+		if input > 0.5 {
+			predicted = append(predicted, "spam")
+		} else {
+			predicted = append(predicted, "ham")
+		}
+	}
+	return predicted, nil
+}
+
+func getNoisyData(count int) ([]float64, []string) {
+	random := rand.New(rand.NewSource(7))
+
+	var inputs []float64
+	var targets []string
+	for idx := 0; idx < count; idx++ {
+		input := random.Float64()
+		inputs = append(inputs, input)
+		if random.Float64() < input {
+			targets = append(targets, "spam")
+		} else {
+			targets = append(targets, "ham")
+		}
+	}
+	return inputs, targets
+}
+
+func TestPlattScaling(t *testing.T) {
+	var scores []float64
+	var targets []bool
+	for idx := 0; idx < 200; idx++ {
+		score := float64(idx)/20 - 5
+		scores = append(scores, score)
+		targets = append(targets, idx%4 != 0 && score > 0 || idx%4 == 0 && score <= 0)
+	}
+
+	platt := NewCalibrator(Platt)
+	err := platt.Fit(scores, targets)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if platt.Transform(4) < 0.6 || platt.Transform(4) > 0.9 {
+		t.Errorf("Platt Scaling Should Soften A Score Right 3/4 Of The Time, Got %f", platt.Transform(4))
+	}
+
+	if platt.Transform(-4) > platt.Transform(0) || platt.Transform(0) > platt.Transform(4) {
+		t.Errorf("Platt Scaling Should Keep The Order Of The Scores")
+	}
+
+	err = platt.Fit(scores, targets[0:10])
+
+	if err == nil || err.Error() != InvalidDataLearn {
+		t.Errorf("Platt Scaling Should Reject Unequal Length")
+	}
+}
+
+func TestIsotonicRegression(t *testing.T) {
+	scores := []float64{0.1, 0.2, 0.3, 0.3, 0.4, 0.5}
+	targets := []bool{false, true, false, true, false, true}
+
+	isotonic := NewCalibrator(Isotonic)
+	err := isotonic.Fit(scores, targets)
+
+	if err != nil {
+		panic(err)
+	}
+
+	expected := map[float64]float64{
+		0.1: 0,
+		0.2: 0.5,
+		0.3: 0.5,
+		0.4: 0.5,
+		0.5: 1,
+		0.9: 1,
+		0:   0,
+	}
+	for score, prob := range expected {
+		if math.Abs(isotonic.Transform(score)-prob) > 1e-9 {
+			t.Errorf("Isotonic Regression Should Give %f To %f, Got %f", prob, score, isotonic.Transform(score))
+		}
+	}
+
+	if math.Abs(isotonic.Transform(0.45)-0.75) > 1e-9 {
+		t.Errorf("Isotonic Regression Should Interpolate Between The Points, Got %f", isotonic.Transform(0.45))
+	}
+}
+
+func TestCalibratedClassifier(t *testing.T) {
+	inputs, targets := getNoisyData(1000)
+
+	factory := func() (classifier.Classifier[float64, string], error) {
+		return &overConfidentClassifier{}, nil
+	}
+
+	raw := &overConfidentClassifier{}
+	rawProbabilities, err := raw.PredictProbability(inputs)
+
+	if err != nil {
+		panic(err)
+	}
+
+	rawLoss, err := metrics.LogLoss(targets, rawProbabilities)
+
+	if err != nil {
+		panic(err)
+	}
+
+	for _, method := range []string{Platt, Isotonic} {
+		calibrated, err := NewCalibratedClassifier(CalibratedClassifierConfig[float64]{
+			Estimator: factory,
+			Method:    method,
+			Seed:      1,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = calibrated.Fit(inputs, targets)
+
+		if err != nil {
+			panic(err)
+		}
+
+		probabilities, err := calibrated.PredictProbability(inputs)
+
+		if err != nil {
+			panic(err)
+		}
+
+		calibratedLoss, err := metrics.LogLoss(targets, probabilities)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if calibratedLoss >= rawLoss {
+			t.Errorf("%s Should Lower The Log Loss, Got %f From %f", method, calibratedLoss, rawLoss)
+		}
+
+		if probabilities[0]["spam"]+probabilities[0]["ham"] < 0.999 || probabilities[0]["spam"]+probabilities[0]["ham"] > 1.001 {
+			t.Errorf("%s Should Normalize The Probabilities, Got %+v", method, probabilities[0])
+		}
+
+		// Samples above the threshold are spam with an average probability of 3/4.
+		high, err := calibrated.PredictProbability([]float64{0.9})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if high[0]["spam"] < 0.65 || high[0]["spam"] > 0.85 {
+			t.Errorf("%s Should Give Spam A Probability Close To 0.75, Got %f", method, high[0]["spam"])
+		}
+
+		predicted, err := calibrated.Predict([]float64{0.9, 0.1})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if predicted[0] != "spam" || predicted[1] != "ham" {
+			t.Errorf("%s Should Keep The Prediction Of The Estimator, Got %v", method, predicted)
+		}
+	}
+
+	_, err = NewCalibratedClassifier(CalibratedClassifierConfig[float64]{
+		Estimator: factory,
+		Method:    "Beta",
+	})
+
+	if err == nil || err.Error() != UnknownMethod {
+		t.Errorf("Calibrated Classifier Should Reject An Unknown Method")
+	}
+}
+
+// recordingClassifier keeps the number of spam targets of every Fit.
+type recordingClassifier struct {
+	overConfidentClassifier
+	spamCounts *[]int
+}
+
+func (rc *recordingClassifier) Fit(inputs []float64, targets []string) error {
+	count := 0
+	for _, target := range targets {
+		if target == "spam" {
+			count++
+		}
+	}
+	*rc.spamCounts = append(*rc.spamCounts, count)
+	return nil
+}
+
+func TestCalibratedClassifierStratifiedFold(t *testing.T) {
+	var inputs []float64
+	var targets []string
+	for idx := 0; idx < 20; idx++ {
+		inputs = append(inputs, float64(idx)/20)
+		if idx < 15 {
+			targets = append(targets, "ham")
+		} else {
+			targets = append(targets, "spam")
+		}
+	}
+
+	var spamCounts []int
+	calibrated, err := NewCalibratedClassifier(CalibratedClassifierConfig[float64]{
+		Estimator: func() (classifier.Classifier[float64, string], error) {
+			return &recordingClassifier{spamCounts: &spamCounts}, nil
+		},
+		Fold: 5,
+		Seed: 1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = calibrated.Fit(inputs, targets)
+
+	if err != nil {
+		panic(err)
+	}
+
+	expected := []int{4, 4, 4, 4, 4, 5}
+	if len(spamCounts) != len(expected) {
+		t.Errorf("Estimator Should Be Fit On Every Fold And Refit On All The Data, Got %d Fits", len(spamCounts))
+		return
+	}
+
+	for idx := range expected {
+		if spamCounts[idx] != expected[idx] {
+			t.Errorf("Every Fold Should Keep The Spam Ratio, Fit %d Got %d Spam Instead Of %d", idx, spamCounts[idx], expected[idx])
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package calibration
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	InvalidDataLearn = "Invalid Data Learn"
+	ModelNotFitted   = "Model Not Fitted"
+
+	Platt    = "Platt"
+	Isotonic = "Isotonic"
+)
+
+// Calibrator maps the score of a class to the probability of the class being the true one.
+type Calibrator interface {
+	Fit(scores []float64, targets []bool) error
+	Transform(score float64) float64
+}
+
+// PlattScaling fits the sigmoid 1 / (1 + exp(A * score + B)) with the Newton method of Lin, Lin
+// and Weng, using the smoothed targets of Platt to avoid overfitting.
+type PlattScaling struct {
+	A float64
+	B float64
+}
+
+// IsotonicRegression fits a non decreasing step function with the pool adjacent violators
+// algorithm, Transform interpolates between the points and clips outside of them.
+type IsotonicRegression struct {
+	X []float64
+	Y []float64
+}
+
+func NewCalibrator(method string) Calibrator {
+	if method == Isotonic {
+		return &IsotonicRegression{}
+	}
+
+	return &PlattScaling{}
+}
+
+func (ps *PlattScaling) Fit(scores []float64, targets []bool) error {
+	if len(scores) == 0 || len(scores) != len(targets) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	positiveCount := float64(0)
+	for _, target := range targets {
+		if target {
+			positiveCount++
+		}
+	}
+	negativeCount := float64(len(targets)) - positiveCount
+
+	highTarget := (positiveCount + 1) / (positiveCount + 2)
+	lowTarget := 1 / (negativeCount + 2)
+
+	smoothed := make([]float64, len(targets))
+	for idx, target := range targets {
+		smoothed[idx] = lowTarget
+		if target {
+			smoothed[idx] = highTarget
+		}
+	}
+
+	getLoss := func(a float64, b float64) float64 {
+		loss := float64(0)
+		for idx, score := range scores {
+			fApB := score*a + b
+			if fApB >= 0 {
+				loss += smoothed[idx]*fApB + math.Log(1+math.Exp(-fApB))
+			} else {
+				loss += (smoothed[idx]-1)*fApB + math.Log(1+math.Exp(fApB))
+			}
+		}
+		return loss
+	}
+
+	const (
+		maxIteration = 100
+		minStep      = 1e-10
+		sigma        = 1e-12
+	)
+
+	a := float64(0)
+	b := math.Log((negativeCount + 1) / (positiveCount + 1))
+	loss := getLoss(a, b)
+
+	for iteration := 0; iteration < maxIteration; iteration++ {
+		h11, h22, h21 := sigma, sigma, float64(0)
+		g1, g2 := float64(0), float64(0)
+		for idx, score := range scores {
+			fApB := score*a + b
+
+			var p, q float64
+			if fApB >= 0 {
+				p = math.Exp(-fApB) / (1 + math.Exp(-fApB))
+				q = 1 / (1 + math.Exp(-fApB))
+			} else {
+				p = 1 / (1 + math.Exp(fApB))
+				q = math.Exp(fApB) / (1 + math.Exp(fApB))
+			}
+
+			d2 := p * q
+			h11 += score * score * d2
+			h22 += d2
+			h21 += score * d2
+
+			d1 := smoothed[idx] - p
+			g1 += score * d1
+			g2 += d1
+		}
+
+		if math.Abs(g1) < 1e-5 && math.Abs(g2) < 1e-5 {
+			break
+		}
+
+		det := h11*h22 - h21*h21
+		dA := -(h22*g1 - h21*g2) / det
+		dB := -(-h21*g1 + h11*g2) / det
+		gd := g1*dA + g2*dB
+
+		step := float64(1)
+		for step >= minStep {
+			newA := a + step*dA
+			newB := b + step*dB
+			newLoss := getLoss(newA, newB)
+
+			if newLoss < loss+0.0001*step*gd {
+				a, b, loss = newA, newB, newLoss
+				break
+			}
+			step /= 2
+		}
+
+		if step < minStep {
+			break
+		}
+	}
+
+	ps.A = a
+	ps.B = b
+
+	return nil
+}
+
+func (ps *PlattScaling) Transform(score float64) float64 {
+	fApB := score*ps.A + ps.B
+	if fApB >= 0 {
+		return math.Exp(-fApB) / (1 + math.Exp(-fApB))
+	}
+
+	return 1 / (1 + math.Exp(fApB))
+}
+
+func (ir *IsotonicRegression) Fit(scores []float64, targets []bool) error {
+	if len(scores) == 0 || len(scores) != len(targets) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	order := make([]int, len(scores))
+	for idx := range order {
+		order[idx] = idx
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] < scores[order[j]]
+	})
+
+	type block struct {
+		lower  float64
+		upper  float64
+		sum    float64
+		weight float64
+	}
+
+	var blocks []block
+	for _, idx := range order {
+		target := float64(0)
+		if targets[idx] {
+			target = 1
+		}
+
+		// Equal scores always share a block, so they get the same probability.
+		if len(blocks) > 0 && blocks[len(blocks)-1].upper == scores[idx] {
+			blocks[len(blocks)-1].sum += target
+			blocks[len(blocks)-1].weight++
+		} else {
+			blocks = append(blocks, block{
+				lower:  scores[idx],
+				upper:  scores[idx],
+				sum:    target,
+				weight: 1,
+			})
+		}
+
+		for len(blocks) > 1 {
+			last := blocks[len(blocks)-1]
+			previous := blocks[len(blocks)-2]
+			if previous.sum/previous.weight <= last.sum/last.weight {
+				break
+			}
+
+			blocks = blocks[0 : len(blocks)-1]
+			blocks[len(blocks)-1] = block{
+				lower:  previous.lower,
+				upper:  last.upper,
+				sum:    previous.sum + last.sum,
+				weight: previous.weight + last.weight,
+			}
+		}
+	}
+
+	ir.X = nil
+	ir.Y = nil
+	for _, b := range blocks {
+		value := b.sum / b.weight
+		ir.X = append(ir.X, b.lower)
+		ir.Y = append(ir.Y, value)
+		if b.upper != b.lower {
+			ir.X = append(ir.X, b.upper)
+			ir.Y = append(ir.Y, value)
+		}
+	}
+
+	return nil
+}
+
+func (ir *IsotonicRegression) Transform(score float64) float64 {
+	if len(ir.X) == 0 {
+		return 0
+	}
+
+	if score <= ir.X[0] {
+		return ir.Y[0]
+	}
+
+	last := len(ir.X) - 1
+	if score >= ir.X[last] {
+		return ir.Y[last]
+	}
+
+	idx := sort.SearchFloat64s(ir.X, score)
+	if ir.X[idx] == score {
+		return ir.Y[idx]
+	}
+
+	ratio := (score - ir.X[idx-1]) / (ir.X[idx] - ir.X[idx-1])
+
+	return ir.Y[idx-1] + ratio*(ir.Y[idx]-ir.Y[idx-1])
+}
@@ -0,0 +1,15 @@
+package classifier
+
+type Estimator[X any, Y any] interface {
+	Fit(inputs []X, targets []Y) error
+	Predict(inputs []X) ([]Y, error)
+}
+
+type Classifier[X any, Y comparable] interface {
+	Estimator[X, Y]
+	PredictProbability(inputs []X) ([]map[Y]float64, error)
+}
+
+// Factory returns a new unfitted classifier, it is used wherever a model has to be trained more
+// than once on different parts of the data.
+type Factory[X any, Y comparable] func() (Classifier[X, Y], error)
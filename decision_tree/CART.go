@@ -3,19 +3,72 @@ package decision_tree
 const (
 	CONTINUOUS  = "continuous"
 	CATEGORICAL = "categorical"
+
+	Gini    = "Gini"
+	Entropy = "Entropy"
+
+	Mean   = "Mean"
+	Median = "Median"
+
+	InvalidDataLearn     = "Invalid Data Learn"
+	UnequalFeatureLength = "Unequal Feature Length"
+	UnknownFeatureType   = "Unknown Feature Type"
+	UnknownCriterion     = "Unknown Criterion"
+	UnknownLeaf          = "Unknown Leaf"
+	CellTypeMismatch     = "Cell Type Mismatch"
+	ModelNotFitted       = "Model Not Fitted"
+
+	DefaultMinSamplesSplit = 2
+	DefaultMinSamplesLeaf  = 1
+	MaxExhaustiveCategory  = 10
 )
 
+// DecisionTreeDataTrain holds a Row for every sample, every Cell of a row has to match the Type
+// of its feature.
 type DecisionTreeDataTrain struct {
 	Features    []string
-	Data        [][]interface{}
+	Data        []Row
 	Type        []string
 	TargetClass []string
+	TargetValue []float64
 }
 
 type DecisionTreeDataGuess struct {
-	Data [][]interface{}
+	Data []Row
 }
 
 type DecisionTree interface {
-	Predict(data DecisionTreeDataGuess)
+	Learn(data DecisionTreeDataTrain) error
+	Predict(data DecisionTreeDataGuess) ([]string, error)
+	PredictProbability(data DecisionTreeDataGuess) ([]map[string]float64, error)
+}
+
+type RegressionDecisionTree interface {
+	Learn(data DecisionTreeDataTrain) error
+	Predict(data DecisionTreeDataGuess) ([]float64, error)
+}
+
+// Node goes to Left when a CONTINUOUS value is lower or equal to Threshold, or when a CATEGORICAL
+// value is one of LeftCategories. A category seen on neither side follows the bigger child.
+// A missing value goes to Left when MissingGoLeft, MissingSamples counts the training samples
+// that were missing the feature of the split.
+// Classification nodes fill Distribution and Class, regression nodes fill Value.
+type Node struct {
+	Feature         int
+	Threshold       float64
+	LeftCategories  []string
+	RightCategories []string
+	MissingGoLeft   bool
+	MissingSamples  int
+	Left            *Node
+	Right           *Node
+	Samples         int
+	Impurity        float64
+	Distribution    map[string]float64
+	Class           string
+	Value           float64
+}
+
+func (n *Node) IsLeaf() bool {
+	return n.Left == nil || n.Right == nil
 }
@@ -0,0 +1,362 @@
+package decision_tree
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+type accumulator interface {
+	add(sample int)
+	remove(sample int)
+	merge(other accumulator)
+	count() float64
+	impurity() float64
+}
+
+type criterion interface {
+	newAccumulator() accumulator
+	newLeaf(samples []int) *Node
+	// categoryOrder sorts the categories when there are too many of them to try every subset.
+	categoryOrder(category accumulator, node accumulator) float64
+}
+
+type split struct {
+	feature         int
+	threshold       float64
+	leftCategories  []string
+	rightCategories []string
+	missingGoLeft   bool
+	missingSamples  int
+	impurity        float64
+}
+
+type treeBuilder struct {
+	types           []string
+	rows            [][]value
+	maxDepth        int
+	minSamplesSplit int
+	minSamplesLeaf  int
+	criterion       criterion
+	// maxFeatures limits every split to a random subset of the features drawn from random,
+	// zero means every feature is tried.
+	maxFeatures int
+	random      *rand.Rand
+}
+
+func (b *treeBuilder) accumulate(samples []int) accumulator {
+	acc := b.criterion.newAccumulator()
+	for _, sample := range samples {
+		acc.add(sample)
+	}
+
+	return acc
+}
+
+func (b *treeBuilder) build(samples []int, depth int) *Node {
+	node := b.criterion.newLeaf(samples)
+
+	if node.Impurity <= 0 || (b.maxDepth > 0 && depth >= b.maxDepth) ||
+		len(samples) < b.minSamplesSplit || len(samples) < 2*b.minSamplesLeaf {
+		return node
+	}
+
+	// When the drawn features hold no split that lowers the impurity, the next features are drawn
+	// from the remaining ones, the node only becomes a leaf once every feature has been tried.
+	features, drawSize := b.getFeatures()
+
+	var best *split
+	for start := 0; start < len(features); start += drawSize {
+		if best != nil && best.impurity < node.Impurity {
+			break
+		}
+
+		end := start + drawSize
+		if end > len(features) {
+			end = len(features)
+		}
+
+		drawn := features[start:end]
+		sort.Ints(drawn)
+
+		for _, feature := range drawn {
+			var candidate *split
+			if b.types[feature] == CONTINUOUS {
+				candidate = b.findContinuousSplit(samples, feature)
+			} else {
+				candidate = b.findCategoricalSplit(samples, feature)
+			}
+
+			if candidate != nil && (best == nil || candidate.impurity < best.impurity) {
+				best = candidate
+			}
+		}
+	}
+
+	if best == nil || best.impurity >= node.Impurity {
+		return node
+	}
+
+	node.Feature = best.feature
+	node.Threshold = best.threshold
+	node.LeftCategories = best.leftCategories
+	node.RightCategories = best.rightCategories
+	node.MissingGoLeft = best.missingGoLeft
+	node.MissingSamples = best.missingSamples
+
+	var leftSamples, rightSamples []int
+	for _, sample := range samples {
+		if goLeft(b.types, node, b.rows[sample]) {
+			leftSamples = append(leftSamples, sample)
+		} else {
+			rightSamples = append(rightSamples, sample)
+		}
+	}
+
+	node.Left = b.build(leftSamples, depth+1)
+	node.Right = b.build(rightSamples, depth+1)
+
+	return node
+}
+
+// getFeatures returns the order the features are tried in and how many are drawn at a time.
+func (b *treeBuilder) getFeatures() ([]int, int) {
+	if b.maxFeatures <= 0 || b.maxFeatures >= len(b.types) {
+		features := make([]int, len(b.types))
+		for idx := range features {
+			features[idx] = idx
+		}
+		return features, len(features)
+	}
+
+	return b.random.Perm(len(b.types)), b.maxFeatures
+}
+
+func (b *treeBuilder) weightedImpurity(left accumulator, right accumulator) float64 {
+	total := left.count() + right.count()
+
+	return (left.count()*left.impurity() + right.count()*right.impurity()) / total
+}
+
+func (b *treeBuilder) combine(first accumulator, second accumulator) accumulator {
+	combined := b.criterion.newAccumulator()
+	combined.merge(first)
+	combined.merge(second)
+
+	return combined
+}
+
+// evaluateSplit sends the missing samples to the side that gives the lower impurity. Without
+// missing samples the default direction is the bigger side.
+func (b *treeBuilder) evaluateSplit(left accumulator, right accumulator, missing accumulator) (float64, bool, bool) {
+	if missing.count() == 0 {
+		if int(left.count()) < b.minSamplesLeaf || int(right.count()) < b.minSamplesLeaf {
+			return 0, false, false
+		}
+		return b.weightedImpurity(left, right), left.count() >= right.count(), true
+	}
+
+	impurity := math.Inf(1)
+	missingGoLeft := false
+	valid := false
+
+	missingLeft := b.combine(left, missing)
+	if int(missingLeft.count()) >= b.minSamplesLeaf && int(right.count()) >= b.minSamplesLeaf {
+		impurity = b.weightedImpurity(missingLeft, right)
+		missingGoLeft = true
+		valid = true
+	}
+
+	missingRight := b.combine(right, missing)
+	if int(left.count()) >= b.minSamplesLeaf && int(missingRight.count()) >= b.minSamplesLeaf {
+		if rightImpurity := b.weightedImpurity(left, missingRight); rightImpurity < impurity {
+			impurity = rightImpurity
+			missingGoLeft = false
+		}
+		valid = true
+	}
+
+	return impurity, missingGoLeft, valid
+}
+
+func (b *treeBuilder) splitMissing(samples []int, feature int) ([]int, accumulator) {
+	var present []int
+	missing := b.criterion.newAccumulator()
+	for _, sample := range samples {
+		if b.rows[sample][feature].missing {
+			missing.add(sample)
+		} else {
+			present = append(present, sample)
+		}
+	}
+
+	return present, missing
+}
+
+func (b *treeBuilder) findContinuousSplit(samples []int, feature int) *split {
+	present, missing := b.splitMissing(samples, feature)
+
+	sort.SliceStable(present, func(i, j int) bool {
+		return b.rows[present[i]][feature].number < b.rows[present[j]][feature].number
+	})
+
+	left := b.criterion.newAccumulator()
+	right := b.accumulate(present)
+
+	var best *split
+	for idx := 0; idx < len(present); idx++ {
+		left.add(present[idx])
+		right.remove(present[idx])
+
+		// With missing samples, keeping every present sample on the left is also a split.
+		current := b.rows[present[idx]][feature].number
+		threshold := current
+		if idx < len(present)-1 {
+			next := b.rows[present[idx+1]][feature].number
+			if current == next {
+				continue
+			}
+			threshold = (current + next) / 2
+		} else if missing.count() == 0 {
+			continue
+		}
+
+		impurity, missingGoLeft, valid := b.evaluateSplit(left, right, missing)
+		if valid && (best == nil || impurity < best.impurity) {
+			best = &split{
+				feature:        feature,
+				threshold:      threshold,
+				missingGoLeft:  missingGoLeft,
+				missingSamples: int(missing.count()),
+				impurity:       impurity,
+			}
+		}
+	}
+
+	return best
+}
+
+// findCategoricalSplit tries every subset when there are few categories, otherwise only the
+// splits along the order given by the criterion are tried.
+func (b *treeBuilder) findCategoricalSplit(samples []int, feature int) *split {
+	present, missing := b.splitMissing(samples, feature)
+
+	categoryAccumulators := make(map[string]accumulator)
+	for _, sample := range present {
+		category := b.rows[sample][feature].category
+		if _, exists := categoryAccumulators[category]; !exists {
+			categoryAccumulators[category] = b.criterion.newAccumulator()
+		}
+		categoryAccumulators[category].add(sample)
+	}
+
+	var categories []string
+	for category := range categoryAccumulators {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	if len(categories) == 0 || (len(categories) == 1 && missing.count() == 0) {
+		return nil
+	}
+
+	var best *split
+	evaluate := func(leftCategories []string, rightCategories []string) {
+		left := b.criterion.newAccumulator()
+		right := b.criterion.newAccumulator()
+		for _, category := range leftCategories {
+			left.merge(categoryAccumulators[category])
+		}
+		for _, category := range rightCategories {
+			right.merge(categoryAccumulators[category])
+		}
+
+		impurity, missingGoLeft, valid := b.evaluateSplit(left, right, missing)
+		if valid && (best == nil || impurity < best.impurity) {
+			best = &split{
+				feature:         feature,
+				leftCategories:  append([]string{}, leftCategories...),
+				rightCategories: append([]string{}, rightCategories...),
+				missingGoLeft:   missingGoLeft,
+				missingSamples:  int(missing.count()),
+				impurity:        impurity,
+			}
+		}
+	}
+
+	if len(categories) == 1 {
+		evaluate(categories, nil)
+		return best
+	}
+
+	if len(categories) <= MaxExhaustiveCategory {
+		// The last category always stays on the right, so every partition is only tried once.
+		for mask := 1; mask < 1<<(len(categories)-1); mask++ {
+			var left, right []string
+			for idx, category := range categories {
+				if mask&(1<<idx) != 0 {
+					left = append(left, category)
+				} else {
+					right = append(right, category)
+				}
+			}
+			evaluate(left, right)
+		}
+
+		return best
+	}
+
+	node := b.accumulate(present)
+	order := make(map[string]float64)
+	for _, category := range categories {
+		order[category] = b.criterion.categoryOrder(categoryAccumulators[category], node)
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		return order[categories[i]] < order[categories[j]]
+	})
+
+	for idx := 1; idx < len(categories); idx++ {
+		evaluate(categories[0:idx], categories[idx:])
+	}
+
+	return best
+}
+
+func goLeft(types []string, node *Node, row []value) bool {
+	if row[node.Feature].missing {
+		return node.MissingGoLeft
+	}
+
+	if types[node.Feature] == CONTINUOUS {
+		return row[node.Feature].number <= node.Threshold
+	}
+
+	category := row[node.Feature].category
+	for _, leftCategory := range node.LeftCategories {
+		if category == leftCategory {
+			return true
+		}
+	}
+
+	for _, rightCategory := range node.RightCategories {
+		if category == rightCategory {
+			return false
+		}
+	}
+
+	return node.Left.Samples >= node.Right.Samples
+}
+
+func getLeaf(root *Node, types []string, row []value) *Node {
+	node := root
+	for !node.IsLeaf() {
+		if goLeft(types, node, row) {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+
+	return node
+}
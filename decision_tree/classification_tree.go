@@ -0,0 +1,301 @@
+package decision_tree
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+type ClassificationTreeConfig struct {
+	Criterion       string
+	MaxDepth        int
+	MinSamplesSplit int
+	MinSamplesLeaf  int
+	CCPAlpha        float64
+}
+
+type ClassificationTree struct {
+	criterion       string
+	maxDepth        int
+	minSamplesSplit int
+	minSamplesLeaf  int
+	ccpAlpha        float64
+	features        []string
+	types           []string
+	classes         []string
+	root            *Node
+}
+
+type classificationCriterion struct {
+	name    string
+	classes []string
+	targets []int
+}
+
+type classAccumulator struct {
+	criterion *classificationCriterion
+	counts    []float64
+	total     float64
+}
+
+func (c *classificationCriterion) newAccumulator() accumulator {
+	return &classAccumulator{
+		criterion: c,
+		counts:    make([]float64, len(c.classes)),
+	}
+}
+
+func (c *classificationCriterion) getImpurity(counts []float64, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	impurity := float64(0)
+	if c.name == Entropy {
+		for _, count := range counts {
+			if count > 0 {
+				p := count / total
+				impurity -= p * math.Log2(p)
+			}
+		}
+		return impurity
+	}
+
+	impurity = 1
+	for _, count := range counts {
+		p := count / total
+		impurity -= p * p
+	}
+
+	return impurity
+}
+
+func (c *classificationCriterion) newLeaf(samples []int) *Node {
+	counts := make([]float64, len(c.classes))
+	for _, sample := range samples {
+		counts[c.targets[sample]] += 1
+	}
+
+	node := Node{
+		Feature:      -1,
+		Samples:      len(samples),
+		Impurity:     c.getImpurity(counts, float64(len(samples))),
+		Distribution: make(map[string]float64),
+	}
+
+	highestCount := float64(-1)
+	for idx, count := range counts {
+		if count > 0 {
+			node.Distribution[c.classes[idx]] = count
+		}
+		if highestCount < count {
+			node.Class = c.classes[idx]
+			highestCount = count
+		}
+	}
+
+	return &node
+}
+
+// categoryOrder is the share of the majority class of the node inside the category.
+func (c *classificationCriterion) categoryOrder(category accumulator, node accumulator) float64 {
+	categoryCounts := category.(*classAccumulator)
+	nodeCounts := node.(*classAccumulator)
+
+	majorityClass := 0
+	for idx := range nodeCounts.counts {
+		if nodeCounts.counts[majorityClass] < nodeCounts.counts[idx] {
+			majorityClass = idx
+		}
+	}
+
+	return categoryCounts.counts[majorityClass] / categoryCounts.total
+}
+
+func (a *classAccumulator) add(sample int) {
+	a.counts[a.criterion.targets[sample]] += 1
+	a.total += 1
+}
+
+func (a *classAccumulator) remove(sample int) {
+	a.counts[a.criterion.targets[sample]] -= 1
+	a.total -= 1
+}
+
+func (a *classAccumulator) merge(other accumulator) {
+	otherCounts := other.(*classAccumulator)
+	for idx, count := range otherCounts.counts {
+		a.counts[idx] += count
+	}
+	a.total += otherCounts.total
+}
+
+func (a *classAccumulator) count() float64 {
+	return a.total
+}
+
+func (a *classAccumulator) impurity() float64 {
+	return a.criterion.getImpurity(a.counts, a.total)
+}
+
+// indexClasses returns the sorted distinct classes and the index of every target inside them.
+func indexClasses(targetClass []string) ([]string, []int) {
+	classIndex := make(map[string]int)
+	var classes []string
+	for _, corpusClass := range targetClass {
+		if _, exists := classIndex[corpusClass]; !exists {
+			classIndex[corpusClass] = 0
+			classes = append(classes, corpusClass)
+		}
+	}
+	sort.Strings(classes)
+	for idx, corpusClass := range classes {
+		classIndex[corpusClass] = idx
+	}
+
+	targets := make([]int, len(targetClass))
+	for idx, corpusClass := range targetClass {
+		targets[idx] = classIndex[corpusClass]
+	}
+
+	return classes, targets
+}
+
+func NewClassificationTree(cfg ClassificationTreeConfig) (*ClassificationTree, error) {
+	if cfg.Criterion == "" {
+		cfg.Criterion = Gini
+	}
+
+	if cfg.Criterion != Gini && cfg.Criterion != Entropy {
+		return nil, errors.New(UnknownCriterion)
+	}
+
+	if cfg.MinSamplesSplit < DefaultMinSamplesSplit {
+		cfg.MinSamplesSplit = DefaultMinSamplesSplit
+	}
+
+	if cfg.MinSamplesLeaf < DefaultMinSamplesLeaf {
+		cfg.MinSamplesLeaf = DefaultMinSamplesLeaf
+	}
+
+	return &ClassificationTree{
+		criterion:       cfg.Criterion,
+		maxDepth:        cfg.MaxDepth,
+		minSamplesSplit: cfg.MinSamplesSplit,
+		minSamplesLeaf:  cfg.MinSamplesLeaf,
+		ccpAlpha:        cfg.CCPAlpha,
+	}, nil
+}
+
+var _ DecisionTree = (*ClassificationTree)(nil)
+
+func (t *ClassificationTree) Learn(data DecisionTreeDataTrain) error {
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetClass) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	err := validateType(data.Features, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := convertData(data.Data, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	t.features = data.Features
+	t.types = data.Type
+
+	var targets []int
+	t.classes, targets = indexClasses(data.TargetClass)
+
+	builder := treeBuilder{
+		types:           t.types,
+		rows:            rows,
+		maxDepth:        t.maxDepth,
+		minSamplesSplit: t.minSamplesSplit,
+		minSamplesLeaf:  t.minSamplesLeaf,
+		criterion: &classificationCriterion{
+			name:    t.criterion,
+			classes: t.classes,
+			targets: targets,
+		},
+	}
+
+	samples := make([]int, len(rows))
+	for idx := range samples {
+		samples[idx] = idx
+	}
+
+	t.root = builder.build(samples, 0)
+
+	if t.ccpAlpha > 0 {
+		t.Prune(t.ccpAlpha)
+	}
+
+	return nil
+}
+
+func (t *ClassificationTree) PredictProbability(data DecisionTreeDataGuess) ([]map[string]float64, error) {
+	if t.root == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, t.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var allPrediction []map[string]float64
+	for _, converted := range rows {
+		leaf := getLeaf(t.root, t.types, converted)
+
+		predictedClass := make(map[string]float64)
+		for _, corpusClass := range t.classes {
+			predictedClass[corpusClass] = leaf.Distribution[corpusClass] / float64(leaf.Samples)
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (t *ClassificationTree) Predict(data DecisionTreeDataGuess) ([]string, error) {
+	if t.root == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, t.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, converted := range rows {
+		predicted = append(predicted, getLeaf(t.root, t.types, converted).Class)
+	}
+
+	return predicted, nil
+}
+
+func (t *ClassificationTree) GetRoot() *Node {
+	return t.root
+}
+
+func (t *ClassificationTree) GetClasses() []string {
+	return t.classes
+}
+
+func (t *ClassificationTree) GetFeatures() []string {
+	return t.features
+}
+
+func (t *ClassificationTree) GetType() []string {
+	return t.types
+}
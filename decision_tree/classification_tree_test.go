@@ -0,0 +1,232 @@
+package decision_tree
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	Features = []string{"Outlook", "Temperature", "Humidity", "Windy"}
+	Type     = []string{CATEGORICAL, CONTINUOUS, CONTINUOUS, CATEGORICAL}
+	Data     = []Row{
+		{Category("sunny"), Number(85), Number(85), Category("false")},
+		{Category("sunny"), Number(80), Number(90), Category("true")},
+		{Category("overcast"), Number(83), Number(86), Category("false")},
+		{Category("rainy"), Number(70), Number(96), Category("false")},
+		{Category("rainy"), Number(68), Number(80), Category("false")},
+		{Category("rainy"), Number(65), Number(70), Category("true")},
+		{Category("overcast"), Number(64), Number(65), Category("true")},
+		{Category("sunny"), Number(72), Number(95), Category("false")},
+		{Category("sunny"), Number(69), Number(70), Category("false")},
+		{Category("rainy"), Number(75), Number(80), Category("false")},
+		{Category("sunny"), Number(75), Number(70), Category("true")},
+		{Category("overcast"), Number(72), Number(90), Category("true")},
+		{Category("overcast"), Number(81), Number(75), Category("false")},
+		{Category("rainy"), Number(71), Number(91), Category("true")},
+	}
+	TargetClass = []string{
+		"no", "no", "yes", "yes", "yes", "no", "yes", "no", "yes", "yes", "yes", "yes", "yes", "no",
+	}
+)
+
+func TestClassificationTree(t *testing.T) {
+	for _, criterion := range []string{Gini, Entropy} {
+		tree, err := NewClassificationTree(ClassificationTreeConfig{
+			Criterion: criterion,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = tree.Learn(DecisionTreeDataTrain{
+			Features:    Features,
+			Data:        Data,
+			Type:        Type,
+			TargetClass: TargetClass,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := tree.Predict(DecisionTreeDataGuess{Data: Data})
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx := range predicted {
+			if predicted[idx] != TargetClass[idx] {
+				t.Errorf("%s Tree Should Fit Row %d As %s", criterion, idx, TargetClass[idx])
+			}
+		}
+
+		probabilities, err := tree.PredictProbability(DecisionTreeDataGuess{Data: []Row{
+			{Category("overcast"), Number(60), Number(99), Category("true")},
+		}})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if probabilities[0]["yes"] != 1 {
+			t.Errorf("%s Tree Should Predict Overcast As Yes", criterion)
+		}
+	}
+
+	stump, err := NewClassificationTree(ClassificationTreeConfig{
+		MaxDepth: 1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = stump.Learn(DecisionTreeDataTrain{
+		Features:    Features,
+		Data:        Data,
+		Type:        Type,
+		TargetClass: TargetClass,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	root := stump.GetRoot()
+	if root.IsLeaf() || !root.Left.IsLeaf() || !root.Right.IsLeaf() {
+		t.Errorf("Tree With Max Depth 1 Should Only Split Once")
+	}
+
+	if root.Samples != len(TargetClass) {
+		t.Errorf("Root Should Hold %d Samples", len(TargetClass))
+	}
+
+	_, err = stump.Predict(DecisionTreeDataGuess{Data: []Row{
+		{Category("sunny"), Category("hot"), Number(80), Category("true")},
+	}})
+
+	if err == nil || err.Error() != CellTypeMismatch {
+		t.Errorf("Category For A Continuous Feature Should Return %s", CellTypeMismatch)
+	}
+
+	_, err = stump.Predict(DecisionTreeDataGuess{Data: []Row{
+		{Category("sunny"), Number(80), Number(80)},
+	}})
+
+	if err == nil || err.Error() != UnequalFeatureLength {
+		t.Errorf("Row With A Missing Feature Should Return %s", UnequalFeatureLength)
+	}
+}
+
+// toRow reads a row of an export, where nil is a missing cell.
+func toRow(cells []interface{}) Row {
+	var row Row
+	for _, cell := range cells {
+		switch v := cell.(type) {
+		case float64:
+			row = append(row, Number(v))
+		case string:
+			row = append(row, Category(v))
+		default:
+			row = append(row, Missing())
+		}
+	}
+	return row
+}
+
+func TestClassificationTreeMissingValue(t *testing.T) {
+	var missingData []Row
+	for _, cells := range [][]interface{}{
+		{"sunny", 85.0, 85.0, "false"},
+		{"sunny", 80.0, nil, "true"},
+		{nil, 83.0, 86.0, "false"},
+		{"rainy", 70.0, 96.0, "false"},
+		{"rainy", 68.0, 80.0, "false"},
+		{"rainy", 65.0, 70.0, "true"},
+		{"overcast", 64.0, 65.0, "true"},
+		{"sunny", 72.0, nil, "false"},
+		{"sunny", 69.0, 70.0, "false"},
+		{"rainy", 75.0, 80.0, "false"},
+		{"sunny", 75.0, 70.0, "true"},
+		{"overcast", 72.0, 90.0, "true"},
+		{nil, 81.0, 75.0, "false"},
+		{"rainy", 71.0, 91.0, "true"},
+	} {
+		missingData = append(missingData, toRow(cells))
+	}
+
+	tree, err := NewClassificationTree(ClassificationTreeConfig{})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = tree.Learn(DecisionTreeDataTrain{
+		Features:    Features,
+		Data:        missingData,
+		Type:        Type,
+		TargetClass: TargetClass,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	predicted, err := tree.Predict(DecisionTreeDataGuess{Data: missingData})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range predicted {
+		if predicted[idx] != TargetClass[idx] {
+			t.Errorf("Tree Should Fit Row %d With Missing Value As %s", idx, TargetClass[idx])
+		}
+	}
+
+	_, err = tree.PredictProbability(DecisionTreeDataGuess{Data: []Row{
+		toRow([]interface{}{nil, nil, nil, nil}),
+		{Category("sunny"), Number(math.NaN()), Missing(), Missing()},
+	}})
+
+	if err != nil {
+		t.Errorf("Missing Value Should Be Predicted, Got %s", err.Error())
+	}
+}
+
+func TestClassificationTreeBlankCategory(t *testing.T) {
+	tree, err := NewClassificationTree(ClassificationTreeConfig{})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = tree.Learn(DecisionTreeDataTrain{
+		Data: []Row{
+			{Category("")}, {Category("")}, {Category("x")}, {Category("x")}, {Missing()},
+		},
+		Type:        []string{CATEGORICAL},
+		TargetClass: []string{"blank", "blank", "x", "x", "x"},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	root := tree.GetRoot()
+	if root.IsLeaf() || root.MissingSamples != 1 {
+		t.Errorf("Blank Category Should Be Split On And Only The Missing Cell Counted As Missing")
+	}
+
+	predicted, err := tree.Predict(DecisionTreeDataGuess{Data: []Row{{Category("")}, {Category("x")}}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predicted[0] != "blank" || predicted[1] != "x" {
+		t.Errorf("Blank Category Should Be Predicted As Its Own Class, Got %v", predicted)
+	}
+}
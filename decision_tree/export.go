@@ -0,0 +1,148 @@
+package decision_tree
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func getFeatureName(features []string, feature int) string {
+	if feature < len(features) && features[feature] != "" {
+		return features[feature]
+	}
+
+	return fmt.Sprintf("X[%d]", feature)
+}
+
+func getCondition(node *Node, features []string, types []string, left bool) string {
+	name := getFeatureName(features, node.Feature)
+
+	var condition string
+	if types[node.Feature] == CONTINUOUS {
+		if left {
+			condition = name + " <= " + strconv.FormatFloat(node.Threshold, 'f', -1, 64)
+		} else {
+			condition = name + " > " + strconv.FormatFloat(node.Threshold, 'f', -1, 64)
+		}
+	} else if left {
+		condition = name + " in [" + strings.Join(node.LeftCategories, ", ") + "]"
+	} else {
+		condition = name + " in [" + strings.Join(node.RightCategories, ", ") + "]"
+	}
+
+	if node.MissingSamples > 0 && node.MissingGoLeft == left {
+		condition += " or missing"
+	}
+
+	return condition
+}
+
+func getDistribution(node *Node) string {
+	var classes []string
+	for corpusClass := range node.Distribution {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	var distribution []string
+	for _, corpusClass := range classes {
+		distribution = append(distribution, corpusClass+": "+strconv.FormatFloat(node.Distribution[corpusClass], 'f', -1, 64))
+	}
+
+	return "[" + strings.Join(distribution, ", ") + "]"
+}
+
+func getPrediction(node *Node) string {
+	if node.Distribution != nil {
+		return "class: " + node.Class
+	}
+
+	return "value: " + strconv.FormatFloat(node.Value, 'f', 4, 64)
+}
+
+func exportText(builder *strings.Builder, node *Node, features []string, types []string, depth int) {
+	indent := strings.Repeat("|   ", depth) + "|--- "
+
+	if node.IsLeaf() {
+		builder.WriteString(indent + getPrediction(node) + "\n")
+		return
+	}
+
+	builder.WriteString(indent + getCondition(node, features, types, true) + "\n")
+	exportText(builder, node.Left, features, types, depth+1)
+	builder.WriteString(indent + getCondition(node, features, types, false) + "\n")
+	exportText(builder, node.Right, features, types, depth+1)
+}
+
+// ExportText renders the rules of the tree, every line below a condition belongs to that branch.
+func ExportText(root *Node, features []string, types []string) string {
+	var builder strings.Builder
+	if root != nil {
+		exportText(&builder, root, features, types, 0)
+	}
+
+	return builder.String()
+}
+
+func exportGraphviz(builder *strings.Builder, node *Node, features []string, types []string, counter *int) int {
+	id := *counter
+	*counter++
+
+	var label []string
+	if !node.IsLeaf() {
+		label = append(label, getCondition(node, features, types, true))
+	}
+	label = append(label, "impurity = "+strconv.FormatFloat(node.Impurity, 'f', 4, 64))
+	label = append(label, "samples = "+strconv.Itoa(node.Samples))
+	if node.Distribution != nil {
+		label = append(label, "value = "+getDistribution(node))
+	}
+	label = append(label, strings.Replace(getPrediction(node), ":", " =", 1))
+
+	builder.WriteString(fmt.Sprintf("%d [label=%s] ;\n", id, strconv.Quote(strings.Join(label, "\n"))))
+
+	if node.IsLeaf() {
+		return id
+	}
+
+	leftId := exportGraphviz(builder, node.Left, features, types, counter)
+	builder.WriteString(fmt.Sprintf("%d -> %d [labeldistance=2.5, labelangle=45, headlabel=\"True\"] ;\n", id, leftId))
+
+	rightId := exportGraphviz(builder, node.Right, features, types, counter)
+	builder.WriteString(fmt.Sprintf("%d -> %d [labeldistance=2.5, labelangle=-45, headlabel=\"False\"] ;\n", id, rightId))
+
+	return id
+}
+
+// ExportGraphviz renders the tree in the DOT language, e.g. `dot -Tpng tree.dot -o tree.png`.
+func ExportGraphviz(root *Node, features []string, types []string) string {
+	var builder strings.Builder
+	builder.WriteString("digraph Tree {\n")
+	builder.WriteString("node [shape=box] ;\n")
+
+	if root != nil {
+		counter := 0
+		exportGraphviz(&builder, root, features, types, &counter)
+	}
+
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+func (t *ClassificationTree) ExportText() string {
+	return ExportText(t.root, t.features, t.types)
+}
+
+func (t *ClassificationTree) ExportGraphviz() string {
+	return ExportGraphviz(t.root, t.features, t.types)
+}
+
+func (t *RegressionTree) ExportText() string {
+	return ExportText(t.root, t.features, t.types)
+}
+
+func (t *RegressionTree) ExportGraphviz() string {
+	return ExportGraphviz(t.root, t.features, t.types)
+}
@@ -0,0 +1,42 @@
+package decision_tree
+
+import (
+	"testing"
+)
+
+func TestExportText(t *testing.T) {
+	expected := "|--- Temperature <= 70\n" +
+		"|   |--- class: yes\n" +
+		"|--- Temperature > 70\n" +
+		"|   |--- Outlook in [sunny]\n" +
+		"|   |   |--- class: no\n" +
+		"|   |--- Outlook in [overcast, rainy] or missing\n" +
+		"|   |   |--- class: yes\n"
+
+	if text := ExportText(getPruningTree(), PruningFeatures, PruningType); text != expected {
+		t.Errorf("Exported Text Should Be\n%s\nGot\n%s", expected, text)
+	}
+
+	if text := ExportText(&Node{Feature: -1, Value: 2.5}, nil, nil); text != "|--- value: 2.5000\n" {
+		t.Errorf("Regression Leaf Should Be Exported With Its Value, Got %s", text)
+	}
+}
+
+func TestExportGraphviz(t *testing.T) {
+	expected := "digraph Tree {\n" +
+		"node [shape=box] ;\n" +
+		`0 [label="Temperature <= 70\nimpurity = 0.3750\nsamples = 8\nvalue = [no: 2, yes: 6]\nclass = yes"] ;` + "\n" +
+		`1 [label="impurity = 0.0000\nsamples = 4\nvalue = [yes: 4]\nclass = yes"] ;` + "\n" +
+		`0 -> 1 [labeldistance=2.5, labelangle=45, headlabel="True"] ;` + "\n" +
+		`2 [label="Outlook in [sunny]\nimpurity = 0.5000\nsamples = 4\nvalue = [no: 2, yes: 2]\nclass = no"] ;` + "\n" +
+		`3 [label="impurity = 0.4444\nsamples = 3\nvalue = [no: 2, yes: 1]\nclass = no"] ;` + "\n" +
+		`2 -> 3 [labeldistance=2.5, labelangle=45, headlabel="True"] ;` + "\n" +
+		`4 [label="impurity = 0.0000\nsamples = 1\nvalue = [yes: 1]\nclass = yes"] ;` + "\n" +
+		`2 -> 4 [labeldistance=2.5, labelangle=-45, headlabel="False"] ;` + "\n" +
+		`0 -> 2 [labeldistance=2.5, labelangle=-45, headlabel="False"] ;` + "\n" +
+		"}\n"
+
+	if dot := ExportGraphviz(getPruningTree(), PruningFeatures, PruningType); dot != expected {
+		t.Errorf("Exported Graphviz Should Be\n%s\nGot\n%s", expected, dot)
+	}
+}
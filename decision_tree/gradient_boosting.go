@@ -0,0 +1,541 @@
+package decision_tree
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+const (
+	InvalidSubsample = "Invalid Subsample"
+
+	DefaultLearningRate  = 0.1
+	DefaultBoostingDepth = 3
+	DefaultSubsample     = 1
+	DefaultTolerance     = 1e-4
+	DefaultPatience      = 5
+)
+
+// GradientBoostingConfig is shared by the classifier and the regressor. Early stopping is only
+// done when ValidationFraction is set, the boosting stops once the validation loss has not
+// improved by Tolerance for Patience iterations and keeps the trees of the best iteration.
+type GradientBoostingConfig struct {
+	TreeCount          int
+	LearningRate       float64
+	MaxDepth           int
+	MinSamplesSplit    int
+	MinSamplesLeaf     int
+	Subsample          float64
+	ValidationFraction float64
+	Tolerance          float64
+	Patience           int
+	Seed               int64
+}
+
+// boostingLoss gives the scores of every output, a single one for the squared error and one per
+// class for the log loss.
+type boostingLoss interface {
+	outputCount() int
+	initialScore(samples []int) []float64
+	// residual is the negative gradient of the loss for every output of the sample.
+	residual(sample int, score []float64) []float64
+	// leafValue is the step of a leaf holding the samples for the given output.
+	leafValue(samples []int, residuals []float64, output int) float64
+	loss(samples []int, scores [][]float64) float64
+}
+
+type squaredErrorLoss struct {
+	targets []float64
+}
+
+func (l *squaredErrorLoss) outputCount() int {
+	return 1
+}
+
+func (l *squaredErrorLoss) initialScore(samples []int) []float64 {
+	sum := float64(0)
+	for _, sample := range samples {
+		sum += l.targets[sample]
+	}
+
+	return []float64{sum / float64(len(samples))}
+}
+
+func (l *squaredErrorLoss) residual(sample int, score []float64) []float64 {
+	return []float64{l.targets[sample] - score[0]}
+}
+
+func (l *squaredErrorLoss) leafValue(samples []int, residuals []float64, output int) float64 {
+	sum := float64(0)
+	for _, sample := range samples {
+		sum += residuals[sample]
+	}
+
+	return sum / float64(len(samples))
+}
+
+func (l *squaredErrorLoss) loss(samples []int, scores [][]float64) float64 {
+	loss := float64(0)
+	for _, sample := range samples {
+		loss += math.Pow(l.targets[sample]-scores[sample][0], 2)
+	}
+
+	return loss / float64(len(samples))
+}
+
+type logLoss struct {
+	classCount int
+	targets    []int
+}
+
+func (l *logLoss) outputCount() int {
+	return l.classCount
+}
+
+func (l *logLoss) initialScore(samples []int) []float64 {
+	counts := make([]float64, l.classCount)
+	for _, sample := range samples {
+		counts[l.targets[sample]] += 1
+	}
+
+	score := make([]float64, l.classCount)
+	for idx, count := range counts {
+		score[idx] = math.Log(math.Max(count/float64(len(samples)), 1e-15))
+	}
+
+	return score
+}
+
+func (l *logLoss) residual(sample int, score []float64) []float64 {
+	residual := softmax(score)
+	for idx := range residual {
+		residual[idx] = -residual[idx]
+	}
+	residual[l.targets[sample]] += 1
+
+	return residual
+}
+
+// leafValue takes a single Newton step of the multinomial deviance.
+func (l *logLoss) leafValue(samples []int, residuals []float64, output int) float64 {
+	numerator := float64(0)
+	denominator := float64(0)
+	for _, sample := range samples {
+		residual := residuals[sample]
+		numerator += residual
+		denominator += math.Abs(residual) * (1 - math.Abs(residual))
+	}
+
+	if denominator < 1e-15 {
+		return 0
+	}
+
+	return float64(l.classCount-1) / float64(l.classCount) * numerator / denominator
+}
+
+func (l *logLoss) loss(samples []int, scores [][]float64) float64 {
+	loss := float64(0)
+	for _, sample := range samples {
+		prob := softmax(scores[sample])[l.targets[sample]]
+		loss -= math.Log(math.Max(prob, 1e-15))
+	}
+
+	return loss / float64(len(samples))
+}
+
+func softmax(scores []float64) []float64 {
+	highestScore := math.Inf(-1)
+	for _, score := range scores {
+		highestScore = math.Max(highestScore, score)
+	}
+
+	prob := make([]float64, len(scores))
+	denominator := float64(0)
+	for idx, score := range scores {
+		prob[idx] = math.Exp(score - highestScore)
+		denominator += prob[idx]
+	}
+
+	for idx := range prob {
+		prob[idx] /= denominator
+	}
+
+	return prob
+}
+
+type gradientBoosting struct {
+	treeCount          int
+	learningRate       float64
+	maxDepth           int
+	minSamplesSplit    int
+	minSamplesLeaf     int
+	subsample          float64
+	validationFraction float64
+	tolerance          float64
+	patience           int
+	seed               int64
+	features           []string
+	types              []string
+	initialScore       []float64
+	// trees holds a tree for every output of every iteration.
+	trees          [][]*Node
+	trainingLoss   []float64
+	validationLoss []float64
+}
+
+func newGradientBoosting(cfg GradientBoostingConfig) (*gradientBoosting, error) {
+	if cfg.TreeCount <= 0 {
+		cfg.TreeCount = DefaultTreeCount
+	}
+
+	if cfg.LearningRate <= 0 {
+		cfg.LearningRate = DefaultLearningRate
+	}
+
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = DefaultBoostingDepth
+	}
+
+	if cfg.MinSamplesSplit < DefaultMinSamplesSplit {
+		cfg.MinSamplesSplit = DefaultMinSamplesSplit
+	}
+
+	if cfg.MinSamplesLeaf < DefaultMinSamplesLeaf {
+		cfg.MinSamplesLeaf = DefaultMinSamplesLeaf
+	}
+
+	if cfg.Subsample == 0 {
+		cfg.Subsample = DefaultSubsample
+	}
+
+	if cfg.Subsample < 0 || cfg.Subsample > 1 {
+		return nil, errors.New(InvalidSubsample)
+	}
+
+	if cfg.Tolerance <= 0 {
+		cfg.Tolerance = DefaultTolerance
+	}
+
+	if cfg.Patience <= 0 {
+		cfg.Patience = DefaultPatience
+	}
+
+	return &gradientBoosting{
+		treeCount:          cfg.TreeCount,
+		learningRate:       cfg.LearningRate,
+		maxDepth:           cfg.MaxDepth,
+		minSamplesSplit:    cfg.MinSamplesSplit,
+		minSamplesLeaf:     cfg.MinSamplesLeaf,
+		subsample:          cfg.Subsample,
+		validationFraction: cfg.ValidationFraction,
+		tolerance:          cfg.Tolerance,
+		patience:           cfg.Patience,
+		seed:               cfg.Seed,
+	}, nil
+}
+
+func (g *gradientBoosting) learn(rows [][]value, boostingLoss boostingLoss) {
+	random := rand.New(rand.NewSource(g.seed))
+	order := random.Perm(len(rows))
+
+	validationLength := int(float64(len(rows)) * g.validationFraction)
+	if validationLength >= len(rows) {
+		validationLength = len(rows) - 1
+	}
+
+	validationSamples := order[0:validationLength]
+	trainSamples := order[validationLength:]
+
+	outputCount := boostingLoss.outputCount()
+	g.initialScore = boostingLoss.initialScore(trainSamples)
+	g.trees = nil
+	g.trainingLoss = nil
+	g.validationLoss = nil
+
+	scores := make([][]float64, len(rows))
+	for idx := range scores {
+		scores[idx] = append([]float64{}, g.initialScore...)
+	}
+
+	residuals := make([][]float64, outputCount)
+	for output := range residuals {
+		residuals[output] = make([]float64, len(rows))
+	}
+
+	subsampleLength := int(math.Ceil(g.subsample * float64(len(trainSamples))))
+
+	bestLoss := math.Inf(1)
+	bestIteration := 0
+	noImprovement := 0
+	for iteration := 0; iteration < g.treeCount; iteration++ {
+		for _, sample := range trainSamples {
+			for output, residual := range boostingLoss.residual(sample, scores[sample]) {
+				residuals[output][sample] = residual
+			}
+		}
+
+		samples := trainSamples
+		if subsampleLength < len(trainSamples) {
+			samples = nil
+			for _, idx := range random.Perm(len(trainSamples))[0:subsampleLength] {
+				samples = append(samples, trainSamples[idx])
+			}
+		}
+
+		trees := make([]*Node, outputCount)
+		for output := range trees {
+			builder := treeBuilder{
+				types:           g.types,
+				rows:            rows,
+				maxDepth:        g.maxDepth,
+				minSamplesSplit: g.minSamplesSplit,
+				minSamplesLeaf:  g.minSamplesLeaf,
+				criterion: &regressionCriterion{
+					leaf:    Mean,
+					targets: residuals[output],
+				},
+			}
+
+			trees[output] = builder.build(samples, 0)
+
+			leafSamples := make(map[*Node][]int)
+			for _, sample := range samples {
+				leaf := getLeaf(trees[output], g.types, rows[sample])
+				leafSamples[leaf] = append(leafSamples[leaf], sample)
+			}
+
+			for leaf, samplesOfLeaf := range leafSamples {
+				leaf.Value = boostingLoss.leafValue(samplesOfLeaf, residuals[output], output)
+			}
+		}
+
+		g.trees = append(g.trees, trees)
+
+		for sample, row := range rows {
+			for output, tree := range trees {
+				scores[sample][output] += g.learningRate * getLeaf(tree, g.types, row).Value
+			}
+		}
+
+		g.trainingLoss = append(g.trainingLoss, boostingLoss.loss(trainSamples, scores))
+
+		if len(validationSamples) == 0 {
+			continue
+		}
+
+		validationLoss := boostingLoss.loss(validationSamples, scores)
+		g.validationLoss = append(g.validationLoss, validationLoss)
+
+		if validationLoss < bestLoss-g.tolerance {
+			bestLoss = validationLoss
+			bestIteration = iteration + 1
+			noImprovement = 0
+		} else {
+			noImprovement++
+		}
+
+		if noImprovement >= g.patience {
+			break
+		}
+	}
+
+	if len(validationSamples) > 0 {
+		g.trees = g.trees[0:bestIteration]
+	}
+}
+
+func (g *gradientBoosting) predictRow(row []value) []float64 {
+	score := append([]float64{}, g.initialScore...)
+	for _, trees := range g.trees {
+		for output, tree := range trees {
+			score[output] += g.learningRate * getLeaf(tree, g.types, row).Value
+		}
+	}
+
+	return score
+}
+
+type GradientBoostingClassifier struct {
+	*gradientBoosting
+	classes []string
+}
+
+type GradientBoostingRegressor struct {
+	*gradientBoosting
+}
+
+// NewGradientBoostingClassifier minimizes the log loss with one tree per class on every iteration.
+func NewGradientBoostingClassifier(cfg GradientBoostingConfig) (*GradientBoostingClassifier, error) {
+	boosting, err := newGradientBoosting(cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GradientBoostingClassifier{
+		gradientBoosting: boosting,
+	}, nil
+}
+
+// NewGradientBoostingRegressor minimizes the squared error of TargetValue.
+func NewGradientBoostingRegressor(cfg GradientBoostingConfig) (*GradientBoostingRegressor, error) {
+	boosting, err := newGradientBoosting(cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GradientBoostingRegressor{
+		gradientBoosting: boosting,
+	}, nil
+}
+
+var _ DecisionTree = (*GradientBoostingClassifier)(nil)
+var _ RegressionDecisionTree = (*GradientBoostingRegressor)(nil)
+
+func (c *GradientBoostingClassifier) Learn(data DecisionTreeDataTrain) error {
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetClass) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	err := validateType(data.Features, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := convertData(data.Data, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	c.features = data.Features
+	c.types = data.Type
+
+	var targets []int
+	c.classes, targets = indexClasses(data.TargetClass)
+
+	c.learn(rows, &logLoss{
+		classCount: len(c.classes),
+		targets:    targets,
+	})
+
+	return nil
+}
+
+func (c *GradientBoostingClassifier) PredictProbability(data DecisionTreeDataGuess) ([]map[string]float64, error) {
+	if c.initialScore == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, c.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var allPrediction []map[string]float64
+	for _, converted := range rows {
+		predictedClass := make(map[string]float64)
+		for idx, prob := range softmax(c.predictRow(converted)) {
+			predictedClass[c.classes[idx]] = prob
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (c *GradientBoostingClassifier) Predict(data DecisionTreeDataGuess) ([]string, error) {
+	if c.initialScore == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, c.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, converted := range rows {
+		predicted = append(predicted, c.classes[getHighestIndex(c.predictRow(converted))])
+	}
+
+	return predicted, nil
+}
+
+func (c *GradientBoostingClassifier) GetClasses() []string {
+	return c.classes
+}
+
+func (r *GradientBoostingRegressor) Learn(data DecisionTreeDataTrain) error {
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetValue) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	err := validateType(data.Features, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := convertData(data.Data, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	r.features = data.Features
+	r.types = data.Type
+
+	r.learn(rows, &squaredErrorLoss{
+		targets: data.TargetValue,
+	})
+
+	return nil
+}
+
+func (r *GradientBoostingRegressor) Predict(data DecisionTreeDataGuess) ([]float64, error) {
+	if r.initialScore == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, r.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []float64
+	for _, converted := range rows {
+		predicted = append(predicted, r.predictRow(converted)[0])
+	}
+
+	return predicted, nil
+}
+
+// GetTrees returns the trees of every kept iteration, one per class for the classifier.
+func (g *gradientBoosting) GetTrees() [][]*Node {
+	return g.trees
+}
+
+// GetTrainingLoss is the loss on the training split after every iteration, the log loss for the
+// classifier and the mean squared error for the regressor.
+func (g *gradientBoosting) GetTrainingLoss() []float64 {
+	return g.trainingLoss
+}
+
+func (g *gradientBoosting) GetValidationLoss() []float64 {
+	return g.validationLoss
+}
+
+func (g *gradientBoosting) GetFeatures() []string {
+	return g.features
+}
+
+func (g *gradientBoosting) GetType() []string {
+	return g.types
+}
@@ -0,0 +1,106 @@
+package decision_tree
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGradientBoostingClassifier(t *testing.T) {
+	booster, err := NewGradientBoostingClassifier(GradientBoostingConfig{
+		TreeCount: 50,
+		Subsample: 0.8,
+		Seed:      1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = booster.Learn(DecisionTreeDataTrain{
+		Features:    Features,
+		Data:        Data,
+		Type:        Type,
+		TargetClass: TargetClass,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	trainingLoss := booster.GetTrainingLoss()
+	if len(trainingLoss) != 50 || trainingLoss[49] >= trainingLoss[0] {
+		t.Errorf("Training Loss Should Decrease Over 50 Iterations")
+	}
+
+	predicted, err := booster.Predict(DecisionTreeDataGuess{Data: Data})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range predicted {
+		if predicted[idx] != TargetClass[idx] {
+			t.Errorf("Boosting Should Fit Row %d As %s", idx, TargetClass[idx])
+		}
+	}
+
+	probabilities, err := booster.PredictProbability(DecisionTreeDataGuess{Data: Data})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if math.Abs(probabilities[0]["yes"]+probabilities[0]["no"]-1) > 1e-9 {
+		t.Errorf("Probability Should Sum To 1")
+	}
+}
+
+func TestGradientBoostingRegressor(t *testing.T) {
+	var data []Row
+	var targets []float64
+	for idx := 0; idx < 200; idx++ {
+		x := float64(idx) / 20
+		data = append(data, Row{Number(x)})
+		targets = append(targets, math.Sin(x))
+	}
+
+	booster, err := NewGradientBoostingRegressor(GradientBoostingConfig{
+		TreeCount:          500,
+		ValidationFraction: 0.2,
+		Seed:               1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = booster.Learn(DecisionTreeDataTrain{
+		Data:        data,
+		Type:        []string{CONTINUOUS},
+		TargetValue: targets,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(booster.GetValidationLoss()) != len(booster.GetTrainingLoss()) {
+		t.Errorf("Validation Loss Should Be Reported On Every Iteration")
+	}
+
+	if len(booster.GetValidationLoss()) == 500 || len(booster.GetTrees()) >= len(booster.GetValidationLoss()) {
+		t.Errorf("Early Stopping Should Keep The Trees Of The Best Iteration")
+	}
+
+	predicted, err := booster.Predict(DecisionTreeDataGuess{Data: data})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range predicted {
+		if math.Abs(predicted[idx]-targets[idx]) > 0.1 {
+			t.Errorf("Boosting Should Predict Row %d Close To %f, Got %f", idx, targets[idx], predicted[idx])
+		}
+	}
+}
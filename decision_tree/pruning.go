@@ -0,0 +1,153 @@
+package decision_tree
+
+import (
+	"math"
+)
+
+type PruningPath struct {
+	Alpha    []float64
+	Impurity []float64
+	Leaves   []int
+}
+
+func copyNode(node *Node) *Node {
+	if node == nil {
+		return nil
+	}
+
+	copied := *node
+	copied.Left = copyNode(node.Left)
+	copied.Right = copyNode(node.Right)
+
+	return &copied
+}
+
+func collapseNode(node *Node) {
+	node.Feature = -1
+	node.Threshold = 0
+	node.LeftCategories = nil
+	node.RightCategories = nil
+	node.Left = nil
+	node.Right = nil
+}
+
+// getSubtreeRisk returns the impurity of the leaves under the node weighted by their share of
+// the root samples, along with the number of those leaves.
+func getSubtreeRisk(node *Node, total float64) (float64, int) {
+	if node.IsLeaf() {
+		return node.Impurity * float64(node.Samples) / total, 1
+	}
+
+	leftRisk, leftLeaves := getSubtreeRisk(node.Left, total)
+	rightRisk, rightLeaves := getSubtreeRisk(node.Right, total)
+
+	return leftRisk + rightRisk, leftLeaves + rightLeaves
+}
+
+// alphaTolerance treats effective alphas this close as equal, so rounding does not split a tie.
+const alphaTolerance = 1e-12
+
+// getEffectiveAlpha adds the increase in risk per removed leaf of every internal node under the
+// node to alphas, in pre-order.
+func getEffectiveAlpha(node *Node, total float64, nodes []*Node, alphas []float64) ([]*Node, []float64) {
+	if node.IsLeaf() {
+		return nodes, alphas
+	}
+
+	subtreeRisk, leaves := getSubtreeRisk(node, total)
+	nodes = append(nodes, node)
+	alphas = append(alphas, (node.Impurity*float64(node.Samples)/total-subtreeRisk)/float64(leaves-1))
+
+	nodes, alphas = getEffectiveAlpha(node.Left, total, nodes, alphas)
+
+	return getEffectiveAlpha(node.Right, total, nodes, alphas)
+}
+
+// findWeakestLinks returns every internal node whose pruning increases the risk the least per
+// removed leaf, tied nodes are all returned so they are pruned in the same step.
+func findWeakestLinks(node *Node, total float64) ([]*Node, float64) {
+	nodes, alphas := getEffectiveAlpha(node, total, nil, nil)
+
+	weakestAlpha := math.Inf(1)
+	for _, alpha := range alphas {
+		weakestAlpha = math.Min(weakestAlpha, alpha)
+	}
+
+	var weakestNodes []*Node
+	for idx, alpha := range alphas {
+		if alpha-weakestAlpha <= alphaTolerance {
+			weakestNodes = append(weakestNodes, nodes[idx])
+		}
+	}
+
+	return weakestNodes, weakestAlpha
+}
+
+func CostComplexityPruningPath(root *Node) PruningPath {
+	var path PruningPath
+	if root == nil || root.Samples == 0 {
+		return path
+	}
+
+	tree := copyNode(root)
+	total := float64(root.Samples)
+	risk, leaves := getSubtreeRisk(tree, total)
+
+	path.Alpha = append(path.Alpha, 0)
+	path.Impurity = append(path.Impurity, risk)
+	path.Leaves = append(path.Leaves, leaves)
+
+	for !tree.IsLeaf() {
+		weakestNodes, alpha := findWeakestLinks(tree, total)
+		for _, weakestNode := range weakestNodes {
+			collapseNode(weakestNode)
+		}
+
+		risk, leaves = getSubtreeRisk(tree, total)
+		path.Alpha = append(path.Alpha, math.Max(alpha, 0))
+		path.Impurity = append(path.Impurity, risk)
+		path.Leaves = append(path.Leaves, leaves)
+	}
+
+	return path
+}
+
+// Prune returns a copy of the tree where every weakest link with an effective alpha
+// lower or equal to the given alpha has been turned into a leaf.
+func Prune(root *Node, alpha float64) *Node {
+	if root == nil || root.Samples == 0 {
+		return copyNode(root)
+	}
+
+	tree := copyNode(root)
+	total := float64(root.Samples)
+
+	for !tree.IsLeaf() {
+		weakestNodes, weakestAlpha := findWeakestLinks(tree, total)
+		if weakestAlpha > alpha {
+			break
+		}
+
+		for _, weakestNode := range weakestNodes {
+			collapseNode(weakestNode)
+		}
+	}
+
+	return tree
+}
+
+func (t *ClassificationTree) CostComplexityPruningPath() PruningPath {
+	return CostComplexityPruningPath(t.root)
+}
+
+func (t *ClassificationTree) Prune(alpha float64) {
+	t.root = Prune(t.root, alpha)
+}
+
+func (t *RegressionTree) CostComplexityPruningPath() PruningPath {
+	return CostComplexityPruningPath(t.root)
+}
+
+func (t *RegressionTree) Prune(alpha float64) {
+	t.root = Prune(t.root, alpha)
+}
@@ -0,0 +1,163 @@
+package decision_tree
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	PruningFeatures = []string{"Temperature", "Outlook"}
+	PruningType     = []string{CONTINUOUS, CATEGORICAL}
+)
+
+// getPruningTree returns a tree of 8 samples whose right split only separates a single sample,
+// so its weakest link is the right node with alpha 1/12 before the root with alpha 1/8.
+func getPruningTree() *Node {
+	return &Node{
+		Feature:      0,
+		Threshold:    70,
+		Samples:      8,
+		Impurity:     0.375,
+		Distribution: map[string]float64{"yes": 6, "no": 2},
+		Class:        "yes",
+		Left: &Node{
+			Feature:      -1,
+			Samples:      4,
+			Distribution: map[string]float64{"yes": 4},
+			Class:        "yes",
+		},
+		Right: &Node{
+			Feature:         1,
+			LeftCategories:  []string{"sunny"},
+			RightCategories: []string{"overcast", "rainy"},
+			MissingSamples:  1,
+			Samples:         4,
+			Impurity:        0.5,
+			Distribution:    map[string]float64{"yes": 2, "no": 2},
+			Class:           "no",
+			Left: &Node{
+				Feature:      -1,
+				Samples:      3,
+				Impurity:     4.0 / 9,
+				Distribution: map[string]float64{"yes": 1, "no": 2},
+				Class:        "no",
+			},
+			Right: &Node{
+				Feature:      -1,
+				Samples:      1,
+				Distribution: map[string]float64{"yes": 1},
+				Class:        "yes",
+			},
+		},
+	}
+}
+
+func countLeaves(node *Node) int {
+	if node.IsLeaf() {
+		return 1
+	}
+
+	return countLeaves(node.Left) + countLeaves(node.Right)
+}
+
+func TestCostComplexityPruning(t *testing.T) {
+	root := getPruningTree()
+	path := CostComplexityPruningPath(root)
+
+	expectedAlpha := []float64{0, 1.0 / 12, 0.125}
+	expectedImpurity := []float64{1.0 / 6, 0.25, 0.375}
+	expectedLeaves := []int{3, 2, 1}
+
+	if len(path.Alpha) != len(expectedAlpha) {
+		t.Errorf("Pruning Path Should Have %d Steps, Got %d", len(expectedAlpha), len(path.Alpha))
+		return
+	}
+
+	for idx := range expectedAlpha {
+		if math.Abs(path.Alpha[idx]-expectedAlpha[idx]) > 1e-9 {
+			t.Errorf("Alpha %d Should Be %f, Got %f", idx, expectedAlpha[idx], path.Alpha[idx])
+		}
+
+		if math.Abs(path.Impurity[idx]-expectedImpurity[idx]) > 1e-9 {
+			t.Errorf("Impurity %d Should Be %f, Got %f", idx, expectedImpurity[idx], path.Impurity[idx])
+		}
+
+		if path.Leaves[idx] != expectedLeaves[idx] {
+			t.Errorf("Leaves %d Should Be %d, Got %d", idx, expectedLeaves[idx], path.Leaves[idx])
+		}
+	}
+
+	for alpha, leaves := range map[float64]int{0.05: 3, 0.1: 2, 0.125: 1} {
+		if pruned := Prune(root, alpha); countLeaves(pruned) != leaves {
+			t.Errorf("Pruning With Alpha %f Should Leave %d Leaves, Got %d", alpha, leaves, countLeaves(pruned))
+		}
+	}
+
+	if countLeaves(root) != 3 {
+		t.Errorf("Pruning Should Not Modify The Given Tree")
+	}
+}
+
+func TestCostComplexityPruningTie(t *testing.T) {
+	// Both children of the root are mirrored, so they share the weakest alpha 1/16 and are pruned
+	// in the same step before the root with alpha 1/8.
+	getChild := func(class string, other string) *Node {
+		return &Node{
+			Feature:      0,
+			Threshold:    70,
+			Samples:      4,
+			Impurity:     0.375,
+			Distribution: map[string]float64{class: 3, other: 1},
+			Class:        class,
+			Left: &Node{
+				Feature:      -1,
+				Samples:      2,
+				Distribution: map[string]float64{class: 2},
+				Class:        class,
+			},
+			Right: &Node{
+				Feature:      -1,
+				Samples:      2,
+				Impurity:     0.5,
+				Distribution: map[string]float64{class: 1, other: 1},
+				Class:        class,
+			},
+		}
+	}
+
+	root := &Node{
+		Feature:         1,
+		LeftCategories:  []string{"sunny"},
+		RightCategories: []string{"overcast", "rainy"},
+		Samples:         8,
+		Impurity:        0.5,
+		Distribution:    map[string]float64{"yes": 4, "no": 4},
+		Class:           "no",
+		Left:            getChild("yes", "no"),
+		Right:           getChild("no", "yes"),
+	}
+
+	path := CostComplexityPruningPath(root)
+
+	expectedAlpha := []float64{0, 1.0 / 16, 0.125}
+	expectedLeaves := []int{4, 2, 1}
+
+	if len(path.Alpha) != len(expectedAlpha) {
+		t.Errorf("Pruning Path Should Have %d Steps, Got %v", len(expectedAlpha), path.Alpha)
+		return
+	}
+
+	for idx := range expectedAlpha {
+		if math.Abs(path.Alpha[idx]-expectedAlpha[idx]) > 1e-9 {
+			t.Errorf("Alpha %d Should Be %f, Got %f", idx, expectedAlpha[idx], path.Alpha[idx])
+		}
+
+		if path.Leaves[idx] != expectedLeaves[idx] {
+			t.Errorf("Leaves %d Should Be %d, Got %d", idx, expectedLeaves[idx], path.Leaves[idx])
+		}
+	}
+
+	if pruned := Prune(root, 1.0/16); countLeaves(pruned) != 2 {
+		t.Errorf("Pruning With The Tied Alpha Should Leave 2 Leaves, Got %d", countLeaves(pruned))
+	}
+}
@@ -0,0 +1,401 @@
+package decision_tree
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+const (
+	DefaultTreeCount = 100
+)
+
+type RandomForestConfig struct {
+	Criterion       string
+	TreeCount       int
+	MaxDepth        int
+	MinSamplesSplit int
+	MinSamplesLeaf  int
+	// MaxFeatures is the number of features tried on every split, the square root of the feature
+	// count is used when it is zero.
+	MaxFeatures int
+	Worker      int
+	Seed        int64
+}
+
+type RandomForest struct {
+	criterion       string
+	treeCount       int
+	maxDepth        int
+	minSamplesSplit int
+	minSamplesLeaf  int
+	maxFeatures     int
+	worker          int
+	seed            int64
+	features        []string
+	types           []string
+	classes         []string
+	trees           []*Node
+	outOfBagError   float64
+	importance      []float64
+}
+
+func NewRandomForest(cfg RandomForestConfig) (*RandomForest, error) {
+	if cfg.Criterion == "" {
+		cfg.Criterion = Gini
+	}
+
+	if cfg.Criterion != Gini && cfg.Criterion != Entropy {
+		return nil, errors.New(UnknownCriterion)
+	}
+
+	if cfg.TreeCount <= 0 {
+		cfg.TreeCount = DefaultTreeCount
+	}
+
+	if cfg.MinSamplesSplit < DefaultMinSamplesSplit {
+		cfg.MinSamplesSplit = DefaultMinSamplesSplit
+	}
+
+	if cfg.MinSamplesLeaf < DefaultMinSamplesLeaf {
+		cfg.MinSamplesLeaf = DefaultMinSamplesLeaf
+	}
+
+	if cfg.Worker <= 0 {
+		cfg.Worker = runtime.NumCPU()
+	}
+
+	return &RandomForest{
+		criterion:       cfg.Criterion,
+		treeCount:       cfg.TreeCount,
+		maxDepth:        cfg.MaxDepth,
+		minSamplesSplit: cfg.MinSamplesSplit,
+		minSamplesLeaf:  cfg.MinSamplesLeaf,
+		maxFeatures:     cfg.MaxFeatures,
+		worker:          cfg.Worker,
+		seed:            cfg.Seed,
+	}, nil
+}
+
+var _ DecisionTree = (*RandomForest)(nil)
+
+// Learn grows every tree on its own bootstrap sample. Each tree draws from a random source seeded
+// up front, so the forest does not depend on the order the workers finish.
+func (f *RandomForest) Learn(data DecisionTreeDataTrain) error {
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetClass) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	err := validateType(data.Features, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := convertData(data.Data, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	f.features = data.Features
+	f.types = data.Type
+
+	var targets []int
+	f.classes, targets = indexClasses(data.TargetClass)
+
+	classCriterion := &classificationCriterion{
+		name:    f.criterion,
+		classes: f.classes,
+		targets: targets,
+	}
+
+	maxFeatures := f.maxFeatures
+	if maxFeatures <= 0 {
+		maxFeatures = int(math.Max(1, math.Floor(math.Sqrt(float64(len(f.types))))))
+	}
+
+	random := rand.New(rand.NewSource(f.seed))
+	seeds := make([]int64, f.treeCount)
+	for idx := range seeds {
+		seeds[idx] = random.Int63()
+	}
+
+	f.trees = make([]*Node, f.treeCount)
+	inBags := make([][]bool, f.treeCount)
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for worker := 0; worker < f.worker; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for treeIdx := range jobs {
+				treeRandom := rand.New(rand.NewSource(seeds[treeIdx]))
+
+				samples := make([]int, len(rows))
+				inBag := make([]bool, len(rows))
+				for idx := range samples {
+					samples[idx] = treeRandom.Intn(len(rows))
+					inBag[samples[idx]] = true
+				}
+
+				builder := treeBuilder{
+					types:           f.types,
+					rows:            rows,
+					maxDepth:        f.maxDepth,
+					minSamplesSplit: f.minSamplesSplit,
+					minSamplesLeaf:  f.minSamplesLeaf,
+					criterion:       classCriterion,
+					maxFeatures:     maxFeatures,
+					random:          treeRandom,
+				}
+
+				f.trees[treeIdx] = builder.build(samples, 0)
+				inBags[treeIdx] = inBag
+			}
+		}()
+	}
+
+	for treeIdx := range f.trees {
+		jobs <- treeIdx
+	}
+	close(jobs)
+	wg.Wait()
+
+	f.outOfBagError = f.getOutOfBagError(rows, targets, inBags)
+
+	f.importance = make([]float64, len(f.types))
+	for _, tree := range f.trees {
+		for idx, importance := range ImpurityImportance(tree, len(f.types)) {
+			f.importance[idx] += importance / float64(len(f.trees))
+		}
+	}
+
+	return nil
+}
+
+// getOutOfBagError votes every sample only with the trees that did not see it during training.
+// Samples that were in the bag of every tree are left out.
+func (f *RandomForest) getOutOfBagError(rows [][]value, targets []int, inBags [][]bool) float64 {
+	evaluated := 0
+	misclassified := 0
+	for sample, row := range rows {
+		votes := make([]float64, len(f.classes))
+		voted := false
+		for treeIdx, tree := range f.trees {
+			if inBags[treeIdx][sample] {
+				continue
+			}
+
+			leaf := getLeaf(tree, f.types, row)
+			for idx, corpusClass := range f.classes {
+				votes[idx] += leaf.Distribution[corpusClass] / float64(leaf.Samples)
+			}
+			voted = true
+		}
+
+		if !voted {
+			continue
+		}
+
+		evaluated++
+		if getHighestIndex(votes) != targets[sample] {
+			misclassified++
+		}
+	}
+
+	if evaluated == 0 {
+		return math.NaN()
+	}
+
+	return float64(misclassified) / float64(evaluated)
+}
+
+func getHighestIndex(values []float64) int {
+	highest := 0
+	for idx := range values {
+		if values[highest] < values[idx] {
+			highest = idx
+		}
+	}
+
+	return highest
+}
+
+func (f *RandomForest) predictRows(rows [][]value) [][]float64 {
+	var allProbability [][]float64
+	for _, row := range rows {
+		probability := make([]float64, len(f.classes))
+		for _, tree := range f.trees {
+			leaf := getLeaf(tree, f.types, row)
+			for idx, corpusClass := range f.classes {
+				probability[idx] += leaf.Distribution[corpusClass] / float64(leaf.Samples) / float64(len(f.trees))
+			}
+		}
+
+		allProbability = append(allProbability, probability)
+	}
+
+	return allProbability
+}
+
+// PredictProbability averages the leaf distribution of every tree.
+func (f *RandomForest) PredictProbability(data DecisionTreeDataGuess) ([]map[string]float64, error) {
+	if len(f.trees) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, f.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var allPrediction []map[string]float64
+	for _, probability := range f.predictRows(rows) {
+		predictedClass := make(map[string]float64)
+		for idx, corpusClass := range f.classes {
+			predictedClass[corpusClass] = probability[idx]
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (f *RandomForest) Predict(data DecisionTreeDataGuess) ([]string, error) {
+	if len(f.trees) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, f.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, probability := range f.predictRows(rows) {
+		predicted = append(predicted, f.classes[getHighestIndex(probability)])
+	}
+
+	return predicted, nil
+}
+
+// PermutationImportance is the drop of accuracy on the given data when the values of a single
+// feature are shuffled, averaged over repeat shuffles.
+func (f *RandomForest) PermutationImportance(data DecisionTreeDataTrain, repeat int) ([]float64, error) {
+	if len(f.trees) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetClass) {
+		return nil, errors.New(InvalidDataLearn)
+	}
+
+	if repeat <= 0 {
+		repeat = 1
+	}
+
+	rows, err := convertData(data.Data, f.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	getAccuracy := func(rows [][]value) float64 {
+		correct := 0
+		for idx, probability := range f.predictRows(rows) {
+			if f.classes[getHighestIndex(probability)] == data.TargetClass[idx] {
+				correct++
+			}
+		}
+
+		return float64(correct) / float64(len(rows))
+	}
+
+	baseline := getAccuracy(rows)
+	random := rand.New(rand.NewSource(f.seed))
+
+	importance := make([]float64, len(f.types))
+	for feature := range f.types {
+		for iteration := 0; iteration < repeat; iteration++ {
+			permuted := make([][]value, len(rows))
+			for idx, row := range rows {
+				permuted[idx] = append([]value{}, row...)
+			}
+
+			for idx, permutedIdx := range random.Perm(len(rows)) {
+				permuted[idx][feature] = rows[permutedIdx][feature]
+			}
+
+			importance[feature] += (baseline - getAccuracy(permuted)) / float64(repeat)
+		}
+	}
+
+	return importance, nil
+}
+
+// ImpurityImportance sums the impurity decrease of the splits on every feature, weighted by the
+// samples reaching them, and normalizes the result to one.
+func ImpurityImportance(root *Node, featureCount int) []float64 {
+	importance := make([]float64, featureCount)
+	if root == nil {
+		return importance
+	}
+
+	var walk func(node *Node)
+	walk = func(node *Node) {
+		if node.IsLeaf() {
+			return
+		}
+
+		importance[node.Feature] += float64(node.Samples)*node.Impurity -
+			float64(node.Left.Samples)*node.Left.Impurity - float64(node.Right.Samples)*node.Right.Impurity
+
+		walk(node.Left)
+		walk(node.Right)
+	}
+	walk(root)
+
+	total := float64(0)
+	for _, val := range importance {
+		total += val
+	}
+
+	if total > 0 {
+		for idx := range importance {
+			importance[idx] /= total
+		}
+	}
+
+	return importance
+}
+
+func (f *RandomForest) GetTrees() []*Node {
+	return f.trees
+}
+
+func (f *RandomForest) GetOutOfBagError() float64 {
+	return f.outOfBagError
+}
+
+func (f *RandomForest) GetFeatureImportance() []float64 {
+	return f.importance
+}
+
+func (f *RandomForest) GetClasses() []string {
+	return f.classes
+}
+
+func (f *RandomForest) GetFeatures() []string {
+	return f.features
+}
+
+func (f *RandomForest) GetType() []string {
+	return f.types
+}
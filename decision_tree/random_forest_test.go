@@ -0,0 +1,116 @@
+package decision_tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRandomForest(t *testing.T) {
+	var allImportance [][]float64
+	for _, worker := range []int{1, 4} {
+		forest, err := NewRandomForest(RandomForestConfig{
+			TreeCount:   50,
+			MaxFeatures: 2,
+			Worker:      worker,
+			Seed:        7,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = forest.Learn(DecisionTreeDataTrain{
+			Features:    Features,
+			Data:        Data,
+			Type:        Type,
+			TargetClass: TargetClass,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if len(forest.GetTrees()) != 50 {
+			t.Errorf("Forest Should Grow 50 Trees")
+		}
+
+		oobError := forest.GetOutOfBagError()
+		if oobError < 0 || oobError > 1 {
+			t.Errorf("Out Of Bag Error Should Be Between 0 And 1, Got %f", oobError)
+		}
+
+		predicted, err := forest.Predict(DecisionTreeDataGuess{Data: Data})
+
+		if err != nil {
+			panic(err)
+		}
+
+		correct := 0
+		for idx := range predicted {
+			if predicted[idx] == TargetClass[idx] {
+				correct++
+			}
+		}
+
+		if correct < len(TargetClass)-2 {
+			t.Errorf("Forest Should Fit The Training Data, Got %d Of %d", correct, len(TargetClass))
+		}
+
+		permutation, err := forest.PermutationImportance(DecisionTreeDataTrain{
+			Data:        Data,
+			TargetClass: TargetClass,
+		}, 3)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if len(permutation) != len(Features) {
+			t.Errorf("Permutation Importance Should Have %d Features", len(Features))
+		}
+
+		allImportance = append(allImportance, forest.GetFeatureImportance())
+	}
+
+	if !reflect.DeepEqual(allImportance[0], allImportance[1]) {
+		t.Errorf("Forest With The Same Seed Should Not Depend On The Worker Count")
+	}
+}
+
+func TestRandomForestDrawRemainingFeatures(t *testing.T) {
+	// Only the last feature can split, so a tree drawing one of the constant features first has
+	// to keep drawing instead of stopping at the root.
+	var data []Row
+	var targetClass []string
+	for idx := 0; idx < 20; idx++ {
+		targetClass = append(targetClass, []string{"no", "yes"}[idx%2])
+		data = append(data, Row{Number(1), Category("same"), Number(0), Number(float64(idx % 2))})
+	}
+
+	forest, err := NewRandomForest(RandomForestConfig{
+		TreeCount:   20,
+		MaxFeatures: 1,
+		Seed:        7,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = forest.Learn(DecisionTreeDataTrain{
+		Features:    []string{"first", "second", "third", "fourth"},
+		Data:        data,
+		Type:        []string{CONTINUOUS, CATEGORICAL, CONTINUOUS, CONTINUOUS},
+		TargetClass: targetClass,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx, tree := range forest.GetTrees() {
+		if tree.IsLeaf() || tree.Feature != 3 {
+			t.Errorf("Tree %d Should Split On The Only Informative Feature", idx)
+		}
+	}
+}
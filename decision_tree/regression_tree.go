@@ -0,0 +1,228 @@
+package decision_tree
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+type RegressionTreeConfig struct {
+	Leaf            string
+	MaxDepth        int
+	MinSamplesSplit int
+	MinSamplesLeaf  int
+	CCPAlpha        float64
+}
+
+type RegressionTree struct {
+	leaf            string
+	maxDepth        int
+	minSamplesSplit int
+	minSamplesLeaf  int
+	ccpAlpha        float64
+	features        []string
+	types           []string
+	root            *Node
+}
+
+type regressionCriterion struct {
+	leaf    string
+	targets []float64
+}
+
+type valueAccumulator struct {
+	criterion *regressionCriterion
+	sum       float64
+	sumSquare float64
+	total     float64
+}
+
+func (c *regressionCriterion) newAccumulator() accumulator {
+	return &valueAccumulator{
+		criterion: c,
+	}
+}
+
+func (c *regressionCriterion) newLeaf(samples []int) *Node {
+	acc := c.newAccumulator()
+	for _, sample := range samples {
+		acc.add(sample)
+	}
+
+	node := Node{
+		Feature:  -1,
+		Samples:  len(samples),
+		Impurity: acc.impurity(),
+	}
+
+	if len(samples) == 0 {
+		return &node
+	}
+
+	if c.leaf == Median {
+		var values []float64
+		for _, sample := range samples {
+			values = append(values, c.targets[sample])
+		}
+		sort.Float64s(values)
+
+		middle := len(values) / 2
+		if len(values)%2 == 0 {
+			node.Value = (values[middle-1] + values[middle]) / 2
+		} else {
+			node.Value = values[middle]
+		}
+	} else {
+		node.Value = acc.(*valueAccumulator).sum / float64(len(samples))
+	}
+
+	return &node
+}
+
+// categoryOrder is the mean target of the category, sorting by it gives the best split for the squared error.
+func (c *regressionCriterion) categoryOrder(category accumulator, node accumulator) float64 {
+	categoryValues := category.(*valueAccumulator)
+
+	return categoryValues.sum / categoryValues.total
+}
+
+func (a *valueAccumulator) add(sample int) {
+	target := a.criterion.targets[sample]
+	a.sum += target
+	a.sumSquare += target * target
+	a.total += 1
+}
+
+func (a *valueAccumulator) remove(sample int) {
+	target := a.criterion.targets[sample]
+	a.sum -= target
+	a.sumSquare -= target * target
+	a.total -= 1
+}
+
+func (a *valueAccumulator) merge(other accumulator) {
+	otherValues := other.(*valueAccumulator)
+	a.sum += otherValues.sum
+	a.sumSquare += otherValues.sumSquare
+	a.total += otherValues.total
+}
+
+func (a *valueAccumulator) count() float64 {
+	return a.total
+}
+
+// impurity is the variance of the targets, which is the mean squared error of predicting their mean.
+func (a *valueAccumulator) impurity() float64 {
+	if a.total == 0 {
+		return 0
+	}
+
+	mean := a.sum / a.total
+
+	return math.Max(a.sumSquare/a.total-mean*mean, 0)
+}
+
+func NewRegressionTree(cfg RegressionTreeConfig) (*RegressionTree, error) {
+	if cfg.Leaf == "" {
+		cfg.Leaf = Mean
+	}
+
+	if cfg.Leaf != Mean && cfg.Leaf != Median {
+		return nil, errors.New(UnknownLeaf)
+	}
+
+	if cfg.MinSamplesSplit < DefaultMinSamplesSplit {
+		cfg.MinSamplesSplit = DefaultMinSamplesSplit
+	}
+
+	if cfg.MinSamplesLeaf < DefaultMinSamplesLeaf {
+		cfg.MinSamplesLeaf = DefaultMinSamplesLeaf
+	}
+
+	return &RegressionTree{
+		leaf:            cfg.Leaf,
+		maxDepth:        cfg.MaxDepth,
+		minSamplesSplit: cfg.MinSamplesSplit,
+		minSamplesLeaf:  cfg.MinSamplesLeaf,
+		ccpAlpha:        cfg.CCPAlpha,
+	}, nil
+}
+
+var _ RegressionDecisionTree = (*RegressionTree)(nil)
+
+func (t *RegressionTree) Learn(data DecisionTreeDataTrain) error {
+	if len(data.Data) == 0 || len(data.Data) != len(data.TargetValue) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	err := validateType(data.Features, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := convertData(data.Data, data.Type)
+
+	if err != nil {
+		return err
+	}
+
+	t.features = data.Features
+	t.types = data.Type
+
+	builder := treeBuilder{
+		types:           t.types,
+		rows:            rows,
+		maxDepth:        t.maxDepth,
+		minSamplesSplit: t.minSamplesSplit,
+		minSamplesLeaf:  t.minSamplesLeaf,
+		criterion: &regressionCriterion{
+			leaf:    t.leaf,
+			targets: data.TargetValue,
+		},
+	}
+
+	samples := make([]int, len(rows))
+	for idx := range samples {
+		samples[idx] = idx
+	}
+
+	t.root = builder.build(samples, 0)
+
+	if t.ccpAlpha > 0 {
+		t.Prune(t.ccpAlpha)
+	}
+
+	return nil
+}
+
+func (t *RegressionTree) Predict(data DecisionTreeDataGuess) ([]float64, error) {
+	if t.root == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	rows, err := convertData(data.Data, t.types)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []float64
+	for _, converted := range rows {
+		predicted = append(predicted, getLeaf(t.root, t.types, converted).Value)
+	}
+
+	return predicted, nil
+}
+
+func (t *RegressionTree) GetRoot() *Node {
+	return t.root
+}
+
+func (t *RegressionTree) GetFeatures() []string {
+	return t.features
+}
+
+func (t *RegressionTree) GetType() []string {
+	return t.types
+}
@@ -0,0 +1,125 @@
+package decision_tree
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	RegressionFeatures = []string{"Size"}
+	RegressionType     = []string{CONTINUOUS}
+	RegressionData     = []Row{
+		{Number(1)}, {Number(2)}, {Number(3)}, {Number(4)}, {Number(5)}, {Number(6)},
+	}
+	TargetValue = []float64{1, 2, 3, 20, 21, 40}
+)
+
+func getDepth(node *Node) int {
+	if node.IsLeaf() {
+		return 0
+	}
+
+	return 1 + int(math.Max(float64(getDepth(node.Left)), float64(getDepth(node.Right))))
+}
+
+func learnRegressionTree(cfg RegressionTreeConfig) *RegressionTree {
+	tree, err := NewRegressionTree(cfg)
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = tree.Learn(DecisionTreeDataTrain{
+		Features:    RegressionFeatures,
+		Data:        RegressionData,
+		Type:        RegressionType,
+		TargetValue: TargetValue,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	return tree
+}
+
+func TestRegressionTreeLeaf(t *testing.T) {
+	guess := DecisionTreeDataGuess{Data: []Row{{Number(2)}, {Number(5)}}}
+
+	for leaf, expected := range map[string][]float64{
+		Mean:   {2, 27},
+		Median: {2, 21},
+	} {
+		tree := learnRegressionTree(RegressionTreeConfig{
+			Leaf:     leaf,
+			MaxDepth: 1,
+		})
+
+		predicted, err := tree.Predict(guess)
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx := range expected {
+			if predicted[idx] != expected[idx] {
+				t.Errorf("%s Leaf Should Predict %f, Got %f", leaf, expected[idx], predicted[idx])
+			}
+		}
+
+		if tree.GetRoot().Threshold != 3.5 {
+			t.Errorf("%s Tree Should Split Size At 3.5, Got %f", leaf, tree.GetRoot().Threshold)
+		}
+	}
+
+	tree := learnRegressionTree(RegressionTreeConfig{})
+
+	predicted, err := tree.Predict(DecisionTreeDataGuess{Data: RegressionData})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range predicted {
+		if predicted[idx] != TargetValue[idx] {
+			t.Errorf("Unbounded Tree Should Fit Row %d As %f, Got %f", idx, TargetValue[idx], predicted[idx])
+		}
+	}
+
+	_, err = NewRegressionTree(RegressionTreeConfig{Leaf: "Mode"})
+
+	if err == nil || err.Error() != UnknownLeaf {
+		t.Errorf("Unknown Leaf Should Return %s", UnknownLeaf)
+	}
+}
+
+func TestRegressionTreeStopping(t *testing.T) {
+	for maxDepth := 1; maxDepth <= 3; maxDepth++ {
+		tree := learnRegressionTree(RegressionTreeConfig{
+			MaxDepth: maxDepth,
+		})
+
+		if depth := getDepth(tree.GetRoot()); depth > maxDepth {
+			t.Errorf("Tree With Max Depth %d Should Not Grow To Depth %d", maxDepth, depth)
+		}
+	}
+
+	tree := learnRegressionTree(RegressionTreeConfig{
+		MinSamplesSplit: len(TargetValue) + 1,
+	})
+
+	if !tree.GetRoot().IsLeaf() || tree.GetRoot().Value != 14.5 {
+		t.Errorf("Root With Less Samples Than Min Samples Split Should Be A Leaf Of The Mean 14.5")
+	}
+
+	tree = learnRegressionTree(RegressionTreeConfig{
+		MinSamplesLeaf: 3,
+	})
+
+	root := tree.GetRoot()
+	if root.IsLeaf() || !root.Left.IsLeaf() || !root.Right.IsLeaf() {
+		t.Errorf("Min Samples Leaf 3 Should Only Allow The Split Into Two Halves")
+	} else if root.Left.Samples != 3 || root.Right.Samples != 3 {
+		t.Errorf("Every Leaf Should Hold 3 Samples, Got %d And %d", root.Left.Samples, root.Right.Samples)
+	}
+}
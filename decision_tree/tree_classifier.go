@@ -0,0 +1,68 @@
+package decision_tree
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+)
+
+// TreeClassifier fits a DecisionTree on rows, so a tree can be used wherever a
+// classifier.Classifier is expected.
+type TreeClassifier[M DecisionTree] struct {
+	model    M
+	features []string
+	types    []string
+	fitted   bool
+}
+
+// NewTreeClassifier takes the unfitted model, the type of every feature of a Row and the optional
+// names of the features.
+func NewTreeClassifier[M DecisionTree](model M, features []string, types []string) *TreeClassifier[M] {
+	return &TreeClassifier[M]{
+		model:    model,
+		features: features,
+		types:    types,
+	}
+}
+
+var _ classifier.Classifier[Row, string] = (*TreeClassifier[*ClassificationTree])(nil)
+var _ classifier.Classifier[Row, string] = (*TreeClassifier[*RandomForest])(nil)
+var _ classifier.Classifier[Row, string] = (*TreeClassifier[*GradientBoostingClassifier])(nil)
+
+func (tc *TreeClassifier[M]) Fit(inputs []Row, targets []string) error {
+	tc.fitted = false
+
+	err := tc.model.Learn(DecisionTreeDataTrain{
+		Features:    tc.features,
+		Data:        inputs,
+		Type:        tc.types,
+		TargetClass: targets,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	tc.fitted = true
+
+	return nil
+}
+
+func (tc *TreeClassifier[M]) Predict(inputs []Row) ([]string, error) {
+	if !tc.fitted {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	return tc.model.Predict(DecisionTreeDataGuess{Data: inputs})
+}
+
+func (tc *TreeClassifier[M]) PredictProbability(inputs []Row) ([]map[string]float64, error) {
+	if !tc.fitted {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	return tc.model.PredictProbability(DecisionTreeDataGuess{Data: inputs})
+}
+
+func (tc *TreeClassifier[M]) GetModel() M {
+	return tc.model
+}
@@ -0,0 +1,97 @@
+package decision_tree
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTreeClassifier(t *testing.T) {
+	tree, err := NewClassificationTree(ClassificationTreeConfig{})
+
+	if err != nil {
+		panic(err)
+	}
+
+	forest, err := NewRandomForest(RandomForestConfig{
+		TreeCount: 20,
+		Seed:      1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	booster, err := NewGradientBoostingClassifier(GradientBoostingConfig{
+		TreeCount: 50,
+		Seed:      1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	treeClassifier := NewTreeClassifier(tree, Features, Type)
+
+	_, err = treeClassifier.Predict(Data)
+
+	if err == nil || err.Error() != ModelNotFitted {
+		t.Errorf("Unfitted Tree Classifier Should Return %s", ModelNotFitted)
+	}
+
+	type rowClassifier interface {
+		Fit(inputs []Row, targets []string) error
+		Predict(inputs []Row) ([]string, error)
+		PredictProbability(inputs []Row) ([]map[string]float64, error)
+	}
+
+	for name, model := range map[string]rowClassifier{
+		"Tree":     treeClassifier,
+		"Forest":   NewTreeClassifier(forest, Features, Type),
+		"Boosting": NewTreeClassifier(booster, Features, Type),
+	} {
+		err = model.Fit(Data, TargetClass)
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := model.Predict(Data)
+
+		if err != nil {
+			panic(err)
+		}
+
+		correct := 0
+		for idx := range predicted {
+			if predicted[idx] == TargetClass[idx] {
+				correct++
+			}
+		}
+
+		if correct < len(TargetClass)-2 {
+			t.Errorf("%s Should Fit The Training Rows, Got %d Of %d", name, correct, len(TargetClass))
+		}
+
+		probabilities, err := model.PredictProbability(Data[0:1])
+
+		if err != nil {
+			panic(err)
+		}
+
+		if math.Abs(probabilities[0]["yes"]+probabilities[0]["no"]-1) > 1e-9 {
+			t.Errorf("%s Probability Should Sum To 1", name)
+		}
+	}
+
+	if treeClassifier.GetModel().GetFeatures()[0] != "Outlook" {
+		t.Errorf("Tree Should Learn The Given Features")
+	}
+
+	_, err = treeClassifier.Predict([]Row{
+		{Category("sunny"), Number(80), Category("true")},
+	})
+
+	if err == nil || err.Error() != UnequalFeatureLength {
+		t.Errorf("Row With A Missing Feature Should Return %s", UnequalFeatureLength)
+	}
+}
@@ -0,0 +1,99 @@
+package decision_tree
+
+import (
+	"errors"
+	"math"
+)
+
+type value struct {
+	number   float64
+	category string
+	missing  bool
+}
+
+// Cell is a single typed value of a Row, made with Number for a CONTINUOUS feature or with
+// Category for a CATEGORICAL one. The zero Cell, also given by Missing, is a missing value of any
+// feature, so a nil cell of an export can be left empty. A NaN number is missing as well, while a
+// blank category is a category of its own.
+type Cell struct {
+	number   float64
+	category string
+	kind     string
+}
+
+// Row holds a Cell for every feature, in the order of DecisionTreeDataTrain.Type.
+type Row []Cell
+
+func Number(number float64) Cell {
+	return Cell{
+		number: number,
+		kind:   CONTINUOUS,
+	}
+}
+
+func Category(category string) Cell {
+	return Cell{
+		category: category,
+		kind:     CATEGORICAL,
+	}
+}
+
+func Missing() Cell {
+	return Cell{}
+}
+
+func (c Cell) IsMissing() bool {
+	return c.kind == "" || (c.kind == CONTINUOUS && math.IsNaN(c.number))
+}
+
+func convertRow(row Row, types []string) ([]value, error) {
+	if len(row) != len(types) {
+		return nil, errors.New(UnequalFeatureLength)
+	}
+
+	converted := make([]value, len(row))
+	for idx, cell := range row {
+		if cell.IsMissing() {
+			converted[idx].missing = true
+			continue
+		}
+
+		if cell.kind != types[idx] {
+			return nil, errors.New(CellTypeMismatch)
+		}
+
+		converted[idx].number = cell.number
+		converted[idx].category = cell.category
+	}
+
+	return converted, nil
+}
+
+func convertData(data []Row, types []string) ([][]value, error) {
+	var rows [][]value
+	for _, row := range data {
+		converted, err := convertRow(row, types)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, converted)
+	}
+
+	return rows, nil
+}
+
+func validateType(features []string, types []string) error {
+	if len(features) != 0 && len(features) != len(types) {
+		return errors.New(UnequalFeatureLength)
+	}
+
+	for _, featureType := range types {
+		if featureType != CONTINUOUS && featureType != CATEGORICAL {
+			return errors.New(UnknownFeatureType)
+		}
+	}
+
+	return nil
+}
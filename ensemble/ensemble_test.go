@@ -0,0 +1,161 @@
+package ensemble
+
+import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/logistic_regression"
+	"testing"
+)
+
+// letterClassifier predicts the class that was seen most with the letter at a fixed position.
+type letterClassifier struct {
+	position int
+	counts   map[byte]map[string]float64
+}
+
+func (lc *letterClassifier) Fit(inputs []string, targets []string) error {
+	lc.counts = make(map[byte]map[string]float64)
+	for idx, input := range inputs {
+		letter := input[lc.position]
+		if lc.counts[letter] == nil {
+			lc.counts[letter] = make(map[string]float64)
+		}
+		lc.counts[letter][targets[idx]] += 1
+	}
+	return nil
+}
+
+func (lc *letterClassifier) PredictProbability(inputs []string) ([]map[string]float64, error) {
+	var probabilities []map[string]float64
+	for _, input := range inputs {
+		prob := make(map[string]float64)
+		total := float64(0)
+		for corpusClass, count := range lc.counts[input[lc.position]] {
+			total += count
+			prob[corpusClass] = count
+		}
+		for corpusClass := range prob {
+			prob[corpusClass] /= total
+		}
+		probabilities = append(probabilities, prob)
+	}
+	return probabilities, nil
+}
+
+func (lc *letterClassifier) Predict(inputs []string) ([]string, error) {
+	probabilities, err := lc.PredictProbability(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, prob := range probabilities {
+		predicted = append(predicted, getHighestClass(prob))
+	}
+	return predicted, nil
+}
+
+type nilEvaluator struct{}
+
+func (ne nilEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	return nil, nil
+}
+
+var (
+	inputs  = []string{"aa", "ab", "ba", "bb", "aa", "ab", "ba", "bb", "aa", "ab", "ba", "bb"}
+	targets = []string{"x", "x", "y", "y", "x", "x", "y", "y", "x", "x", "y", "y"}
+)
+
+func TestVoting(t *testing.T) {
+	for _, voting := range []string{Hard, Soft} {
+		ensemble, err := NewVoting(VotingConfig[string]{
+			Estimators: []classifier.Classifier[string, string]{
+				&letterClassifier{position: 0},
+				&letterClassifier{position: 1},
+			},
+			Weights: []float64{2, 1},
+			Voting:  voting,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = ensemble.Fit(inputs, targets)
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := ensemble.Predict(inputs)
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx := range predicted {
+			if predicted[idx] != targets[idx] {
+				t.Errorf("%s Voting Should Follow The Heavier Estimator On %s", voting, inputs[idx])
+			}
+		}
+	}
+
+	_, err := NewVoting(VotingConfig[string]{
+		Estimators: []classifier.Classifier[string, string]{&letterClassifier{}},
+		Weights:    []float64{1, 1},
+	})
+
+	if err == nil || err.Error() != UnequalWeightLength {
+		t.Errorf("Weights Of Another Length Should Return %s", UnequalWeightLength)
+	}
+}
+
+func TestStacking(t *testing.T) {
+	metaLearner, err := logistic_regression.NewLogisticRegression(logistic_regression.LogisticRegressionConfig{
+		Evaluator:    nilEvaluator{},
+		Optimizer:    logistic_regression.LBFGS,
+		MaxIteration: 100,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	ensemble, err := NewStacking(StackingConfig[string]{
+		Estimators: []classifier.Factory[string, string]{
+			func() (classifier.Classifier[string, string], error) {
+				return &letterClassifier{position: 0}, nil
+			},
+			func() (classifier.Classifier[string, string], error) {
+				return &letterClassifier{position: 1}, nil
+			},
+		},
+		MetaLearner: metaLearner,
+		Fold:        3,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = ensemble.Fit(inputs, targets)
+
+	if err != nil {
+		panic(err)
+	}
+
+	predicted, err := ensemble.Predict(inputs)
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range predicted {
+		if predicted[idx] != targets[idx] {
+			t.Errorf("Stacking Should Learn To Trust The First Letter On %s", inputs[idx])
+		}
+	}
+
+	if len(ensemble.GetEstimators()) != 2 {
+		t.Errorf("Stacking Should Refit Every Estimator")
+	}
+}
@@ -0,0 +1,197 @@
+package ensemble
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math/rand"
+)
+
+// MetaLearner is trained on the probabilities of the estimators, the logistic regression and the
+// multilayer perceptron can be used as it is.
+type MetaLearner interface {
+	FitVector(inputs [][]float64, classes []string) error
+	PredictVectorProbability(inputs [][]float64) ([]map[string]float64, error)
+}
+
+// StackingConfig creates the estimators from their factory, because every fold needs a model that
+// never saw the samples it predicts.
+type StackingConfig[X any] struct {
+	Estimators  []classifier.Factory[X, string]
+	MetaLearner MetaLearner
+	Fold        int
+	Seed        int64
+}
+
+type Stacking[X any] struct {
+	factories   []classifier.Factory[X, string]
+	metaLearner MetaLearner
+	fold        int
+	seed        int64
+	estimators  []classifier.Classifier[X, string]
+	classes     []string
+}
+
+func NewStacking[X any](cfg StackingConfig[X]) (*Stacking[X], error) {
+	if len(cfg.Estimators) == 0 {
+		return nil, errors.New(EstimatorEmpty)
+	}
+
+	if cfg.MetaLearner == nil {
+		return nil, errors.New(MetaLearnerNil)
+	}
+
+	if cfg.Fold == 0 {
+		cfg.Fold = DefaultFold
+	}
+
+	if cfg.Fold < 2 {
+		return nil, errors.New(InvalidFold)
+	}
+
+	return &Stacking[X]{
+		factories:   cfg.Estimators,
+		metaLearner: cfg.MetaLearner,
+		fold:        cfg.Fold,
+		seed:        cfg.Seed,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*Stacking[string])(nil)
+
+// Fit trains the meta learner on the out of fold probabilities of every estimator, then refits
+// the estimators on the whole data for the prediction.
+func (s *Stacking[X]) Fit(inputs []X, targets []string) error {
+	if len(inputs) < s.fold || len(inputs) != len(targets) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	s.classes = getClasses(targets)
+
+	random := rand.New(rand.NewSource(s.seed))
+	order := random.Perm(len(inputs))
+
+	metaInputs := make([][]float64, len(inputs))
+	for fold := 0; fold < s.fold; fold++ {
+		var trainInputs, testInputs []X
+		var trainTargets []string
+		var testIndex []int
+		for position, idx := range order {
+			if position%s.fold == fold {
+				testInputs = append(testInputs, inputs[idx])
+				testIndex = append(testIndex, idx)
+			} else {
+				trainInputs = append(trainInputs, inputs[idx])
+				trainTargets = append(trainTargets, targets[idx])
+			}
+		}
+
+		estimators, err := s.fitEstimators(trainInputs, trainTargets)
+
+		if err != nil {
+			return err
+		}
+
+		foldInputs, err := s.getMetaInputs(estimators, testInputs)
+
+		if err != nil {
+			return err
+		}
+
+		for position, idx := range testIndex {
+			metaInputs[idx] = foldInputs[position]
+		}
+	}
+
+	err := s.metaLearner.FitVector(metaInputs, targets)
+
+	if err != nil {
+		return err
+	}
+
+	s.estimators, err = s.fitEstimators(inputs, targets)
+
+	return err
+}
+
+func (s *Stacking[X]) fitEstimators(inputs []X, targets []string) ([]classifier.Classifier[X, string], error) {
+	var estimators []classifier.Classifier[X, string]
+	for _, factory := range s.factories {
+		estimator, err := factory()
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = estimator.Fit(inputs, targets)
+
+		if err != nil {
+			return nil, err
+		}
+
+		estimators = append(estimators, estimator)
+	}
+
+	return estimators, nil
+}
+
+// getMetaInputs concatenates the probability of every class given by every estimator, a class
+// that an estimator does not know gets a zero.
+func (s *Stacking[X]) getMetaInputs(estimators []classifier.Classifier[X, string], inputs []X) ([][]float64, error) {
+	metaInputs := make([][]float64, len(inputs))
+	for _, estimator := range estimators {
+		probabilities, err := estimator.PredictProbability(inputs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(probabilities) != len(inputs) {
+			return nil, errors.New(UnequalPredictLength)
+		}
+
+		for idx, prob := range probabilities {
+			for _, corpusClass := range s.classes {
+				metaInputs[idx] = append(metaInputs[idx], prob[corpusClass])
+			}
+		}
+	}
+
+	return metaInputs, nil
+}
+
+func (s *Stacking[X]) PredictProbability(inputs []X) ([]map[string]float64, error) {
+	if s.estimators == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	metaInputs, err := s.getMetaInputs(s.estimators, inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.metaLearner.PredictVectorProbability(metaInputs)
+}
+
+func (s *Stacking[X]) Predict(inputs []X) ([]string, error) {
+	probabilities, err := s.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, prob := range probabilities {
+		predicted = append(predicted, getHighestClass(prob))
+	}
+
+	return predicted, nil
+}
+
+func (s *Stacking[X]) GetEstimators() []classifier.Classifier[X, string] {
+	return s.estimators
+}
+
+func (s *Stacking[X]) GetClasses() []string {
+	return s.classes
+}
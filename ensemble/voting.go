@@ -0,0 +1,203 @@
+package ensemble
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"sort"
+)
+
+const (
+	InvalidDataLearn     = "Invalid Data Learn"
+	ModelNotFitted       = "Model Not Fitted"
+	EstimatorEmpty       = "Estimator is Empty"
+	MetaLearnerNil       = "Meta Learner is Nil"
+	UnequalWeightLength  = "Unequal Weight Length"
+	UnequalPredictLength = "Unequal Predict Length"
+	UnknownVoting        = "Unknown Voting"
+	InvalidFold          = "Invalid Fold"
+
+	Hard = "Hard"
+	Soft = "Soft"
+
+	DefaultFold = 5
+)
+
+// VotingConfig weights every estimator by Weights, every estimator has a weight of one when it is
+// empty. Hard voting counts the predicted classes while Soft voting averages the probabilities.
+type VotingConfig[X any] struct {
+	Estimators []classifier.Classifier[X, string]
+	Weights    []float64
+	Voting     string
+}
+
+type Voting[X any] struct {
+	estimators []classifier.Classifier[X, string]
+	weights    []float64
+	voting     string
+	classes    []string
+}
+
+func NewVoting[X any](cfg VotingConfig[X]) (*Voting[X], error) {
+	if len(cfg.Estimators) == 0 {
+		return nil, errors.New(EstimatorEmpty)
+	}
+
+	if cfg.Weights == nil {
+		cfg.Weights = make([]float64, len(cfg.Estimators))
+		for idx := range cfg.Weights {
+			cfg.Weights[idx] = 1
+		}
+	}
+
+	if len(cfg.Weights) != len(cfg.Estimators) {
+		return nil, errors.New(UnequalWeightLength)
+	}
+
+	if cfg.Voting == "" {
+		cfg.Voting = Soft
+	}
+
+	if cfg.Voting != Hard && cfg.Voting != Soft {
+		return nil, errors.New(UnknownVoting)
+	}
+
+	return &Voting[X]{
+		estimators: cfg.Estimators,
+		weights:    cfg.Weights,
+		voting:     cfg.Voting,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*Voting[string])(nil)
+
+func (v *Voting[X]) Fit(inputs []X, targets []string) error {
+	if len(inputs) == 0 || len(inputs) != len(targets) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	for _, estimator := range v.estimators {
+		err := estimator.Fit(inputs, targets)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	v.classes = getClasses(targets)
+
+	return nil
+}
+
+// PredictProbability returns the weighted share of the votes for Hard voting and the weighted
+// average of the estimator probabilities for Soft voting.
+func (v *Voting[X]) PredictProbability(inputs []X) ([]map[string]float64, error) {
+	if v.classes == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	totalWeight := float64(0)
+	for _, weight := range v.weights {
+		totalWeight += weight
+	}
+
+	allPrediction := make([]map[string]float64, len(inputs))
+	for idx := range allPrediction {
+		allPrediction[idx] = make(map[string]float64)
+		for _, corpusClass := range v.classes {
+			allPrediction[idx][corpusClass] = 0
+		}
+	}
+
+	for estimatorIdx, estimator := range v.estimators {
+		weight := v.weights[estimatorIdx] / totalWeight
+
+		if v.voting == Hard {
+			predicted, err := estimator.Predict(inputs)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(predicted) != len(inputs) {
+				return nil, errors.New(UnequalPredictLength)
+			}
+
+			for idx, corpusClass := range predicted {
+				allPrediction[idx][corpusClass] += weight
+			}
+			continue
+		}
+
+		probabilities, err := estimator.PredictProbability(inputs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(probabilities) != len(inputs) {
+			return nil, errors.New(UnequalPredictLength)
+		}
+
+		for idx, prob := range probabilities {
+			for corpusClass, val := range prob {
+				allPrediction[idx][corpusClass] += weight * val
+			}
+		}
+	}
+
+	return allPrediction, nil
+}
+
+func (v *Voting[X]) Predict(inputs []X) ([]string, error) {
+	probabilities, err := v.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, prob := range probabilities {
+		predicted = append(predicted, getHighestClass(prob))
+	}
+
+	return predicted, nil
+}
+
+func (v *Voting[X]) GetClasses() []string {
+	return v.classes
+}
+
+func getClasses(targets []string) []string {
+	exists := make(map[string]bool)
+	var classes []string
+	for _, corpusClass := range targets {
+		if !exists[corpusClass] {
+			exists[corpusClass] = true
+			classes = append(classes, corpusClass)
+		}
+	}
+	sort.Strings(classes)
+
+	return classes
+}
+
+// getHighestClass breaks a tie by taking the class that sorts first, so the prediction does not
+// depend on the order of the map.
+func getHighestClass(prob map[string]float64) string {
+	var classes []string
+	for corpusClass := range prob {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	highestProb := float64(-1)
+	var selectedClass string
+	for _, corpusClass := range classes {
+		if highestProb < prob[corpusClass] {
+			selectedClass = corpusClass
+			highestProb = prob[corpusClass]
+		}
+	}
+
+	return selectedClass
+}
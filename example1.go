@@ -2,8 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/adrian3ka/go-learn-ai/classifier"
 	"github.com/adrian3ka/go-learn-ai/grammar_parser"
-	"github.com/adrian3ka/go-learn-ai/helper"
+	"github.com/adrian3ka/go-learn-ai/model_selection"
 	"github.com/adrian3ka/go-learn-ai/naive_bayes"
 	nfa2 "github.com/adrian3ka/go-learn-ai/nfa"
 	"github.com/adrian3ka/go-learn-ai/tagger"
@@ -13,6 +14,8 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
+	"strings"
 )
 
 func main() {
@@ -109,6 +112,47 @@ func main() {
 		fmt.Println(dataTest[idx], " >> ", p)
 	}
 
+	var corpusClasses []string
+	for corpusClass := range corpuses {
+		corpusClasses = append(corpusClasses, corpusClass)
+	}
+	sort.Strings(corpusClasses)
+
+	var documents, classes []string
+	for _, corpusClass := range corpusClasses {
+		for _, document := range corpuses[corpusClass] {
+			documents = append(documents, document)
+			classes = append(classes, corpusClass)
+		}
+	}
+
+	// The pipeline learns the vocabulary and the IDF of every fold from its training documents only.
+	classifierResult, err := model_selection.CrossValidate(func() (classifier.Classifier[string, string], error) {
+		return model_selection.NewTextPipeline(model_selection.TextPipelineConfig{
+			Parameter: model_selection.TextPipelineParameter{
+				Lower:          true,
+				Smooth:         true,
+				NormalizerType: tf_idf.EuclideanSumSquare,
+			},
+			Classifier: func(evaluator tf_idf.TermFrequencyInverseDocumentFrequency) (classifier.Classifier[string, string], error) {
+				nb := naive_bayes.NewMultinomialNaiveBayes(naive_bayes.MultinomialNaiveBayesConfig{
+					Evaluator: evaluator,
+				})
+				return &nb, nil
+			},
+		})
+	}, documents, classes, model_selection.CrossValidationConfig{
+		Strategy: model_selection.StratifiedKFold,
+		Fold:     4,
+		Seed:     1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Cross Validated Accuracy >> ", classifierResult.Accuracy.Mean, "+/-", classifierResult.Accuracy.Std)
+
 	fmt.Println("============================== POS Tagger ====================================")
 	dir, err := os.Getwd()
 	if err != nil {
@@ -127,18 +171,21 @@ func main() {
 		Default: &defaultTag,
 	})
 
-	border := len(allTuple.Tuple) * 90 / 100
-	trainTuple := allTuple.Tuple[0:border]
-	testTuple := allTuple.Tuple[border:len(allTuple.Tuple)]
+	trainTuple, testTuple, err := model_selection.SplitTaggedSentence(allTuple.Tuple, nil, model_selection.SplitConfig{
+		TestFraction: 0.1,
+		Seed:         1,
+	})
 
-	var testTaggedWord [][2]string
-	testSentence := ""
+	if err != nil {
+		panic(err)
+	}
 
-	for _, sentence := range testTuple {
-		for _, word := range sentence {
-			testTaggedWord = append(testTaggedWord, word)
-			testSentence += word[0] + " "
+	getTags := func(taggedWords [][2]string) []string {
+		var tags []string
+		for _, taggedWord := range taggedWords {
+			tags = append(tags, taggedWord[1])
 		}
+		return tags
 	}
 
 	defaultTagger := tagger.NewDefaultTagger(tagger.DefaultTaggerConfig{
@@ -151,13 +198,13 @@ func main() {
 		panic(err)
 	}
 
-	predictedValue, err := defaultTagger.Predict(testSentence)
+	evaluation, err := tagger.Evaluate(defaultTagger, trainTuple, testTuple, tagger.EvaluationConfig{})
 
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Recall Of Default Tagger Only >> ", helper.CalculateRecall(testTaggedWord, predictedValue))
+	fmt.Println("Accuracy Of Default Tagger Only >> ", evaluation.Accuracy.Accuracy)
 
 	regexTagger := tagger.NewRegexTagger(tagger.RegexTaggerConfig{
 		Patterns:      tagger.DefaultSimpleIndonesianRegexTagger,
@@ -170,13 +217,13 @@ func main() {
 		panic(err)
 	}
 
-	predictedValue, err = regexTagger.Predict(testSentence)
+	evaluation, err = tagger.Evaluate(regexTagger, trainTuple, testTuple, tagger.EvaluationConfig{})
 
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Recall Of Regex Tagger With Backoff >> ", helper.CalculateRecall(testTaggedWord, predictedValue))
+	fmt.Println("Accuracy Of Regex Tagger With Backoff >> ", evaluation.Accuracy.Accuracy)
 
 	unigramTagger := tagger.NewUnigramTagger(tagger.UnigramTaggerConfig{
 		BackoffTagger: regexTagger,
@@ -188,13 +235,13 @@ func main() {
 		panic(err)
 	}
 
-	predictedValue, err = unigramTagger.Predict(testSentence)
+	evaluation, err = tagger.Evaluate(unigramTagger, trainTuple, testTuple, tagger.EvaluationConfig{})
 
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Recall Of Unigram Tagger With Backoff >> ", helper.CalculateRecall(testTaggedWord, predictedValue))
+	fmt.Println("Accuracy Of Unigram Tagger With Backoff >> ", evaluation.Accuracy.Accuracy)
 
 	bigramTagger := tagger.NewNGramTagger(tagger.NGramTaggerConfig{
 		BackoffTagger: unigramTagger,
@@ -207,13 +254,13 @@ func main() {
 		panic(err)
 	}
 
-	predictedValue, err = bigramTagger.Predict(testSentence)
+	evaluation, err = tagger.Evaluate(bigramTagger, trainTuple, testTuple, tagger.EvaluationConfig{})
 
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Recall Of Bigram Tagger With Backoff >> ", helper.CalculateRecall(testTaggedWord, predictedValue))
+	fmt.Println("Accuracy Of Bigram Tagger With Backoff >> ", evaluation.Accuracy.Accuracy)
 
 	trigramTagger := tagger.NewNGramTagger(tagger.NGramTaggerConfig{
 		BackoffTagger: bigramTagger,
@@ -226,13 +273,76 @@ func main() {
 		panic(err)
 	}
 
-	predictedValue, err = trigramTagger.Predict(testSentence)
+	evaluation, err = tagger.Evaluate(trigramTagger, trainTuple, testTuple, tagger.EvaluationConfig{})
+
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Accuracy Of Trigram Tagger With Backoff >> ", evaluation.Accuracy.Accuracy)
+
+	fmt.Println(evaluation)
+
+	taggerResult, err := model_selection.CrossValidateFunc(allTuple.Tuple, nil, model_selection.CrossValidationConfig{
+		Strategy: model_selection.KFold,
+		Fold:     10,
+		Seed:     1,
+	}, func(train [][][2]string, test [][][2]string) ([]string, []string, error) {
+		var backoffTagger tagger.Tagger = tagger.NewRegexTagger(tagger.RegexTaggerConfig{
+			Patterns: tagger.DefaultSimpleIndonesianRegexTagger,
+			BackoffTagger: tagger.NewDefaultTagger(tagger.DefaultTaggerConfig{
+				DefaultTag: "nn",
+			}),
+		})
+
+		backoffTagger = tagger.NewUnigramTagger(tagger.UnigramTaggerConfig{
+			BackoffTagger: backoffTagger,
+		})
+
+		err := backoffTagger.Learn(train)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, n := range []uint64{2, 3} {
+			backoffTagger = tagger.NewNGramTagger(tagger.NGramTaggerConfig{
+				BackoffTagger: backoffTagger,
+				N:             n,
+			})
+
+			err = backoffTagger.Learn(train)
+
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		var trueTags, predictedTags []string
+		for _, sentence := range test {
+			var words []string
+			for _, word := range sentence {
+				words = append(words, word[0])
+			}
+
+			predictedSentence, err := backoffTagger.Predict(strings.Join(words, " "))
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			trueTags = append(trueTags, getTags(sentence)...)
+			predictedTags = append(predictedTags, getTags(predictedSentence)...)
+		}
+
+		return trueTags, predictedTags, nil
+	})
 
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Println("Recall Of Trigram Tagger With Backoff >> ", helper.CalculateRecall(testTaggedWord, predictedValue))
+	fmt.Println("Cross Validated Accuracy Of Trigram Tagger >> ", taggerResult.Accuracy.Mean, "+/-", taggerResult.Accuracy.Std)
 
 	fmt.Println("=================================== NFA ======================================")
 	nfa, state0, err := nfa2.NewNFA("State 0", false)
@@ -290,7 +400,7 @@ func main() {
 
 	text := "Menteri Perhubungan Ignasius Jonan dan Jaksa Agung Prasetyo , menandatangani MoU tentang kordinasi dalam pelaksanaan tugas dan fungsi"
 
-	predictedValue, err = bigramTagger.Predict(text)
+	predictedValue, err := bigramTagger.Predict(text)
 
 	if err != nil {
 		panic(err)
@@ -345,4 +455,14 @@ func main() {
 		}
 	}
 	fmt.Println("-----------------------------------------------------------------------")
+
+	chunkEvaluation, err := gp.Evaluate([][][3]string{
+		{{"Nama", "NN", "B-NP"}, {"saya", "PRP", "I-NP"}, {"Adrian", "NN", "B-NP"}, {"Eka", "NN", "I-NP"}, {"Sanjaya", "NN", "I-NP"}, {".", ".", "O"}},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(chunkEvaluation)
 }
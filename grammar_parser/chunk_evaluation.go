@@ -0,0 +1,284 @@
+package grammar_parser
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	InvalidIOBTag       = "Invalid IOB Tag"
+	UnequalParsedLength = "Unequal Parsed Length"
+	EmptyGoldData       = "Empty Gold Data"
+
+	Outside = "O"
+	Begin   = "B-"
+	Inside  = "I-"
+)
+
+// Chunk spans the words of a sentence from Start up to, but not including, End.
+type Chunk struct {
+	Label string   `json:"label"`
+	Start int      `json:"start"`
+	End   int      `json:"end"`
+	Words []string `json:"words"`
+}
+
+type ChunkMetric struct {
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+	Correct   int     `json:"correct"`
+	Predicted int     `json:"predicted"`
+	Gold      int     `json:"gold"`
+}
+
+// ChunkError is a chunk of the sentence at index Sentence that was missed or spurious.
+type ChunkError struct {
+	Sentence int   `json:"sentence"`
+	Chunk    Chunk `json:"chunk"`
+}
+
+// ChunkEvaluation follows CoNLL, a predicted chunk is only correct when its label and both of its
+// boundaries match a gold chunk, so a partial match is counted as missed and as spurious.
+type ChunkEvaluation struct {
+	Labels   []string               `json:"labels"`
+	Metric   map[string]ChunkMetric `json:"metric"`
+	Overall  ChunkMetric            `json:"overall"`
+	Missed   []ChunkError           `json:"missed"`
+	Spurious []ChunkError           `json:"spurious"`
+}
+
+// IOBToChunks reads the chunks of a sentence of word, tag and IOB tag like B-NP, I-NP or O. An
+// I- tag that does not continue a chunk of the same label starts a new one.
+func IOBToChunks(sentence [][3]string) ([]Chunk, error) {
+	var chunks []Chunk
+	var current *Chunk
+	for idx, word := range sentence {
+		iob := word[2]
+
+		if iob == Outside {
+			current = nil
+			continue
+		}
+
+		if (!strings.HasPrefix(iob, Begin) && !strings.HasPrefix(iob, Inside)) || len(iob) == len(Begin) {
+			return nil, errors.New(InvalidIOBTag)
+		}
+
+		label := iob[len(Begin):]
+		if strings.HasPrefix(iob, Begin) || current == nil || current.Label != label {
+			chunks = append(chunks, Chunk{
+				Label: label,
+				Start: idx,
+			})
+			current = &chunks[len(chunks)-1]
+		}
+
+		current.End = idx + 1
+		current.Words = append(current.Words, word[0])
+	}
+
+	return chunks, nil
+}
+
+// ParsedGrammarToChunks turns the output of RegexpParser.Parse into chunks, words without a
+// general tag are left outside of any chunk.
+func ParsedGrammarToChunks(parsedGrammars []ParsedGrammar) []Chunk {
+	var chunks []Chunk
+	position := 0
+	for _, parsedGrammar := range parsedGrammars {
+		if parsedGrammar.GeneralTag != nil && len(parsedGrammar.Words) > 0 {
+			var words []string
+			for _, word := range parsedGrammar.Words {
+				words = append(words, word[0])
+			}
+
+			chunks = append(chunks, Chunk{
+				Label: *parsedGrammar.GeneralTag,
+				Start: position,
+				End:   position + len(parsedGrammar.Words),
+				Words: words,
+			})
+		}
+		position += len(parsedGrammar.Words)
+	}
+
+	return chunks
+}
+
+func getChunkMetric(correct int, predicted int, gold int) ChunkMetric {
+	metric := ChunkMetric{
+		Correct:   correct,
+		Predicted: predicted,
+		Gold:      gold,
+	}
+
+	if predicted > 0 {
+		metric.Precision = float64(correct) / float64(predicted)
+	}
+
+	if gold > 0 {
+		metric.Recall = float64(correct) / float64(gold)
+	}
+
+	if metric.Precision+metric.Recall > 0 {
+		metric.F1 = 2 * metric.Precision * metric.Recall / (metric.Precision + metric.Recall)
+	}
+
+	return metric
+}
+
+// EvaluateChunks compares the predicted chunks of every sentence with its gold chunks.
+func EvaluateChunks(gold [][]Chunk, predicted [][]Chunk) (*ChunkEvaluation, error) {
+	if len(gold) != len(predicted) {
+		return nil, errors.New(UnequalParsedLength)
+	}
+
+	if len(gold) == 0 {
+		return nil, errors.New(EmptyGoldData)
+	}
+
+	type span struct {
+		label string
+		start int
+		end   int
+	}
+
+	correctCount := make(map[string]int)
+	predictedCount := make(map[string]int)
+	goldCount := make(map[string]int)
+
+	evaluation := ChunkEvaluation{
+		Metric: make(map[string]ChunkMetric),
+	}
+
+	for sentenceIdx := range gold {
+		goldSpans := make(map[span]bool)
+		for _, chunk := range gold[sentenceIdx] {
+			goldSpans[span{chunk.Label, chunk.Start, chunk.End}] = true
+			goldCount[chunk.Label]++
+		}
+
+		predictedSpans := make(map[span]bool)
+		for _, chunk := range predicted[sentenceIdx] {
+			predictedSpans[span{chunk.Label, chunk.Start, chunk.End}] = true
+			predictedCount[chunk.Label]++
+
+			if goldSpans[span{chunk.Label, chunk.Start, chunk.End}] {
+				correctCount[chunk.Label]++
+			} else {
+				evaluation.Spurious = append(evaluation.Spurious, ChunkError{
+					Sentence: sentenceIdx,
+					Chunk:    chunk,
+				})
+			}
+		}
+
+		for _, chunk := range gold[sentenceIdx] {
+			if !predictedSpans[span{chunk.Label, chunk.Start, chunk.End}] {
+				evaluation.Missed = append(evaluation.Missed, ChunkError{
+					Sentence: sentenceIdx,
+					Chunk:    chunk,
+				})
+			}
+		}
+	}
+
+	labels := make(map[string]bool)
+	for label := range goldCount {
+		labels[label] = true
+	}
+	for label := range predictedCount {
+		labels[label] = true
+	}
+
+	totalCorrect, totalPredicted, totalGold := 0, 0, 0
+	for label := range labels {
+		evaluation.Labels = append(evaluation.Labels, label)
+		evaluation.Metric[label] = getChunkMetric(correctCount[label], predictedCount[label], goldCount[label])
+
+		totalCorrect += correctCount[label]
+		totalPredicted += predictedCount[label]
+		totalGold += goldCount[label]
+	}
+	sort.Strings(evaluation.Labels)
+
+	evaluation.Overall = getChunkMetric(totalCorrect, totalPredicted, totalGold)
+
+	return &evaluation, nil
+}
+
+// Evaluate parses the word and tag of every gold sentence and compares the chunks with the IOB
+// tags of the sentence.
+func (rp *RegexpParser) Evaluate(gold [][][3]string) (*ChunkEvaluation, error) {
+	var goldChunks, predictedChunks [][]Chunk
+	for _, sentence := range gold {
+		chunks, err := IOBToChunks(sentence)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var input [][2]string
+		for _, word := range sentence {
+			input = append(input, [2]string{word[0], word[1]})
+		}
+
+		err = rp.ResetAllNfa()
+
+		if err != nil {
+			return nil, err
+		}
+
+		parsedGrammars, err := rp.Parse(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parsedLength := 0
+		for _, parsedGrammar := range parsedGrammars {
+			parsedLength += len(parsedGrammar.Words)
+		}
+
+		if parsedLength != len(sentence) {
+			return nil, errors.New(UnequalParsedLength)
+		}
+
+		goldChunks = append(goldChunks, chunks)
+		predictedChunks = append(predictedChunks, ParsedGrammarToChunks(parsedGrammars))
+	}
+
+	return EvaluateChunks(goldChunks, predictedChunks)
+}
+
+func (c Chunk) String() string {
+	return fmt.Sprintf("%s[%d:%d] %s", c.Label, c.Start, c.End, strings.Join(c.Words, " "))
+}
+
+func (e *ChunkEvaluation) String() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-10s %10s %10s %10s %10s %10s\n", "", "precision", "recall", "f1", "predicted", "gold"))
+
+	writeMetric := func(name string, metric ChunkMetric) {
+		builder.WriteString(fmt.Sprintf("%-10s %10.4f %10.4f %10.4f %10d %10d\n",
+			name, metric.Precision, metric.Recall, metric.F1, metric.Predicted, metric.Gold))
+	}
+
+	for _, label := range e.Labels {
+		writeMetric(label, e.Metric[label])
+	}
+	writeMetric("overall", e.Overall)
+
+	for _, missed := range e.Missed {
+		builder.WriteString(fmt.Sprintf("missed   #%d %s\n", missed.Sentence, missed.Chunk))
+	}
+
+	for _, spurious := range e.Spurious {
+		builder.WriteString(fmt.Sprintf("spurious #%d %s\n", spurious.Sentence, spurious.Chunk))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,76 @@
+package grammar_parser
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIOBToChunks(t *testing.T) {
+	chunks, err := IOBToChunks([][3]string{
+		{"nasi", "NN", "I-NP"},
+		{"goreng", "NN", "I-NP"},
+		{"di", "IN", "B-PP"},
+		{"rumah", "NN", "I-NP"},
+		{".", ".", "O"},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(chunks) != 3 || chunks[0].Start != 0 || chunks[0].End != 2 || chunks[2].Label != "NP" || chunks[2].Start != 3 {
+		t.Errorf("An I- Tag Without A Chunk Of The Same Label Should Start A New Chunk, Got %+v", chunks)
+	}
+
+	_, err = IOBToChunks([][3]string{
+		{"nasi", "NN", "X-NP"},
+	})
+
+	if err == nil || err.Error() != InvalidIOBTag {
+		t.Errorf("IOB Tag Without B- Or I- Prefix Should Be Rejected")
+	}
+}
+
+func TestRegexpParserEvaluate(t *testing.T) {
+	gp, err := NewRegexpParser(RegexpParserConfig{
+		Grammar: [][2]string{
+			{"NP", "{<NN>+}"},
+		},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	gold := [][][3]string{
+		{{"saya", "PRP", "B-NP"}, {"makan", "VB", "O"}, {"nasi", "NN", "B-NP"}, {"goreng", "NN", "I-NP"}, {".", ".", "O"}},
+		{{"nasi", "NN", "B-NP"}, {"enak", "JJ", "I-NP"}, {"rumah", "NN", "B-NP"}},
+	}
+
+	evaluation, err := gp.Evaluate(gold)
+
+	if err != nil {
+		panic(err)
+	}
+
+	metric := evaluation.Metric["NP"]
+	if metric.Correct != 2 || metric.Predicted != 3 || metric.Gold != 4 {
+		t.Errorf("NP Should Have 2 Correct Of 3 Predicted And 4 Gold Chunks, Got %+v", metric)
+	}
+
+	if math.Abs(metric.Precision-2.0/3) > 1e-9 || metric.Recall != 0.5 || math.Abs(metric.F1-4.0/7) > 1e-9 {
+		t.Errorf("NP Should Have Precision 2/3, Recall 1/2 And F1 4/7, Got %+v", metric)
+	}
+
+	if evaluation.Overall != metric {
+		t.Errorf("Overall Should Equal The Only Label, Got %+v", evaluation.Overall)
+	}
+
+	if len(evaluation.Missed) != 2 || evaluation.Missed[1].Sentence != 1 || evaluation.Missed[1].Chunk.String() != "NP[0:2] nasi enak" {
+		t.Errorf("Partially Matched Gold Chunk Should Be Missed, Got %+v", evaluation.Missed)
+	}
+
+	if len(evaluation.Spurious) != 1 || evaluation.Spurious[0].Chunk.String() != "NP[0:1] nasi" {
+		t.Errorf("Partially Matched Predicted Chunk Should Be Spurious, Got %+v", evaluation.Spurious)
+	}
+}
@@ -2,7 +2,6 @@ package helper
 
 import (
 	"math/rand"
-	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -67,42 +66,6 @@ func LastSplit(text string, rune string) []string {
 		result[len(result)-1],
 	}
 }
-func CalculateRecall(trueSlice interface{}, predictedSlice interface{}) float64 {
-	trueReflection := reflect.ValueOf(trueSlice)
-	if trueReflection.Kind() != reflect.Slice {
-		panic("InterfaceSlice() given a non-slice type")
-	}
-
-	trueValue := make([]interface{}, trueReflection.Len())
-
-	for i := 0; i < trueReflection.Len(); i++ {
-		trueValue[i] = trueReflection.Index(i).Interface()
-	}
-
-	predictedReflection := reflect.ValueOf(predictedSlice)
-	if predictedReflection.Kind() != reflect.Slice {
-		panic("InterfaceSlice() given a non-slice type")
-	}
-
-	predictedValue := make([]interface{}, predictedReflection.Len())
-
-	for i := 0; i < predictedReflection.Len(); i++ {
-		predictedValue[i] = predictedReflection.Index(i).Interface()
-	}
-
-	truePositive := float64(0)
-	falseNegative := float64(0)
-
-	for i := 0; i < len(predictedValue)-1; i++ {
-		if predictedValue[i] != trueValue[i] {
-			falseNegative += 1
-		} else {
-			truePositive += 1
-		}
-	}
-	return truePositive / (truePositive + falseNegative)
-}
-
 func RandFloats(min, max float64, n int) []float64 {
 	res := make([]float64, n)
 	for i := range res {
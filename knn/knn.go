@@ -0,0 +1,282 @@
+package knn
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math"
+	"sort"
+)
+
+const (
+	InvalidDataLearn     = "Invalid Data Learn"
+	UnequalFeatureLength = "Unequal Feature Length"
+	ModelNotFitted       = "Model Not Fitted"
+	EvaluatorNil         = "Evaluator is Nil"
+	UnknownDistance      = "Unknown Distance"
+	InvalidK             = "Invalid K"
+
+	Cosine    = "Cosine"
+	Euclidean = "Euclidean"
+
+	DefaultK = 5
+)
+
+type Evaluator interface {
+	EvaluateInput(input []string) ([][]float64, error)
+	GetTrainedData() map[string][][]float64
+}
+
+type KNearestNeighborConfig struct {
+	Evaluator Evaluator
+	K         int
+	Distance  string
+	Weighted  bool
+}
+
+type sample struct {
+	class    string
+	document string
+	index    int
+	vector   []float64
+}
+
+type Neighbor struct {
+	Class    string
+	Document string
+	Index    int
+	Distance float64
+	Weight   float64
+}
+
+type Prediction struct {
+	Class       string
+	Probability map[string]float64
+	Neighbors   []Neighbor
+}
+
+type KNearestNeighbor struct {
+	evaluator Evaluator
+	k         int
+	distance  string
+	weighted  bool
+	samples   []sample
+}
+
+// NewKNearestNeighbor stores the trained data of the evaluator right away, Fit replaces it
+// with the given documents and keeps their text for the returned neighbors.
+func NewKNearestNeighbor(cfg KNearestNeighborConfig) (*KNearestNeighbor, error) {
+	if cfg.Evaluator == nil {
+		return nil, errors.New(EvaluatorNil)
+	}
+
+	if cfg.K < 0 {
+		return nil, errors.New(InvalidK)
+	}
+
+	if cfg.K == 0 {
+		cfg.K = DefaultK
+	}
+
+	if cfg.Distance == "" {
+		cfg.Distance = Cosine
+	}
+
+	if cfg.Distance != Cosine && cfg.Distance != Euclidean {
+		return nil, errors.New(UnknownDistance)
+	}
+
+	knn := KNearestNeighbor{
+		evaluator: cfg.Evaluator,
+		k:         cfg.K,
+		distance:  cfg.Distance,
+		weighted:  cfg.Weighted,
+	}
+
+	var classes []string
+	for corpusClass := range cfg.Evaluator.GetTrainedData() {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	for _, corpusClass := range classes {
+		for idx, vector := range cfg.Evaluator.GetTrainedData()[corpusClass] {
+			knn.samples = append(knn.samples, sample{
+				class:  corpusClass,
+				index:  idx,
+				vector: knn.normalize(vector),
+			})
+		}
+	}
+
+	return &knn, nil
+}
+
+var _ classifier.Classifier[string, string] = (*KNearestNeighbor)(nil)
+
+func (knn *KNearestNeighbor) normalize(vector []float64) []float64 {
+	normalized := append([]float64{}, vector...)
+	if knn.distance != Cosine {
+		return normalized
+	}
+
+	norm := float64(0)
+	for _, val := range vector {
+		norm += val * val
+	}
+
+	if norm == 0 {
+		return normalized
+	}
+
+	norm = math.Sqrt(norm)
+	for idx := range normalized {
+		normalized[idx] /= norm
+	}
+
+	return normalized
+}
+
+func (knn *KNearestNeighbor) getDistance(a, b []float64) float64 {
+	if knn.distance == Cosine {
+		similarity := float64(0)
+		for idx := range a {
+			similarity += a[idx] * b[idx]
+		}
+		return 1 - similarity
+	}
+
+	distance := float64(0)
+	for idx := range a {
+		distance += math.Pow(a[idx]-b[idx], 2)
+	}
+
+	return math.Sqrt(distance)
+}
+
+func (knn *KNearestNeighbor) Fit(documents []string, classes []string) error {
+	if len(documents) == 0 || len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	evaluatedInputs, err := knn.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return err
+	}
+
+	counter := make(map[string]int)
+	knn.samples = nil
+	for idx, vector := range evaluatedInputs {
+		knn.samples = append(knn.samples, sample{
+			class:    classes[idx],
+			document: documents[idx],
+			index:    counter[classes[idx]],
+			vector:   knn.normalize(vector),
+		})
+		counter[classes[idx]]++
+	}
+
+	return nil
+}
+
+func (knn *KNearestNeighbor) PredictWithNeighbor(documents []string) ([]Prediction, error) {
+	if len(knn.samples) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	evaluatedInputs, err := knn.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predictions []Prediction
+	for _, evaluatedInput := range evaluatedInputs {
+		if len(evaluatedInput) != len(knn.samples[0].vector) {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+
+		vector := knn.normalize(evaluatedInput)
+
+		var neighbors []Neighbor
+		for _, s := range knn.samples {
+			neighbors = append(neighbors, Neighbor{
+				Class:    s.class,
+				Document: s.document,
+				Index:    s.index,
+				Distance: knn.getDistance(vector, s.vector),
+			})
+		}
+
+		sort.SliceStable(neighbors, func(i, j int) bool {
+			return neighbors[i].Distance < neighbors[j].Distance
+		})
+
+		if len(neighbors) > knn.k {
+			neighbors = neighbors[0:knn.k]
+		}
+
+		prediction := Prediction{
+			Probability: make(map[string]float64),
+			Neighbors:   neighbors,
+		}
+
+		totalWeight := float64(0)
+		for idx := range neighbors {
+			neighbors[idx].Weight = 1
+			if knn.weighted {
+				neighbors[idx].Weight = 1 / math.Max(neighbors[idx].Distance, 1e-12)
+			}
+
+			prediction.Probability[neighbors[idx].Class] += neighbors[idx].Weight
+			totalWeight += neighbors[idx].Weight
+		}
+
+		highestProb := float64(-1)
+		for _, neighbor := range neighbors {
+			prob := prediction.Probability[neighbor.Class]
+			if highestProb < prob {
+				prediction.Class = neighbor.Class
+				highestProb = prob
+			}
+		}
+
+		for corpusClass, prob := range prediction.Probability {
+			prediction.Probability[corpusClass] = prob / totalWeight
+		}
+
+		predictions = append(predictions, prediction)
+	}
+
+	return predictions, nil
+}
+
+func (knn *KNearestNeighbor) Predict(documents []string) ([]string, error) {
+	predictions, err := knn.PredictWithNeighbor(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, prediction := range predictions {
+		predicted = append(predicted, prediction.Class)
+	}
+
+	return predicted, nil
+}
+
+func (knn *KNearestNeighbor) PredictProbability(documents []string) ([]map[string]float64, error) {
+	predictions, err := knn.PredictWithNeighbor(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var probabilities []map[string]float64
+	for _, prediction := range predictions {
+		probabilities = append(probabilities, prediction.Probability)
+	}
+
+	return probabilities, nil
+}
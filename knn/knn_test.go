@@ -0,0 +1,173 @@
+package knn
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// pointEvaluator reads a document like "1,2" as the vector {1, 2}.
+type pointEvaluator struct {
+	trainedData map[string][][]float64
+}
+
+func (pe pointEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	var evaluatedInput [][]float64
+	for _, document := range input {
+		var vector []float64
+		for _, field := range strings.Split(document, ",") {
+			val, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, err
+			}
+			vector = append(vector, val)
+		}
+		evaluatedInput = append(evaluatedInput, vector)
+	}
+	return evaluatedInput, nil
+}
+
+func (pe pointEvaluator) GetTrainedData() map[string][][]float64 {
+	return pe.trainedData
+}
+
+func TestVoting(t *testing.T) {
+	documents := []string{"0,0", "0,1", "1,0", "5,5", "5,6"}
+	classes := []string{"a", "a", "a", "b", "b"}
+
+	knn, err := NewKNearestNeighbor(KNearestNeighborConfig{
+		Evaluator: pointEvaluator{},
+		K:         3,
+		Distance:  Euclidean,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = knn.Fit(documents, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	predictions, err := knn.PredictWithNeighbor([]string{"4,4", "1,1"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predictions[0].Class != "b" || predictions[1].Class != "a" {
+		t.Errorf("Majority Of 3 Neighbors Should Predict b And a, Got %s And %s", predictions[0].Class, predictions[1].Class)
+	}
+
+	if math.Abs(predictions[0].Probability["b"]-2.0/3) > 1e-9 || len(predictions[0].Neighbors) != 3 {
+		t.Errorf("Probability Of b Should Be 2/3 Of 3 Neighbors, Got %+v", predictions[0])
+	}
+
+	if predictions[0].Neighbors[0].Document != "5,5" {
+		t.Errorf("Nearest Neighbor Should Come First, Got %s", predictions[0].Neighbors[0].Document)
+	}
+
+	weighted, err := NewKNearestNeighbor(KNearestNeighborConfig{
+		Evaluator: pointEvaluator{},
+		K:         3,
+		Distance:  Euclidean,
+		Weighted:  true,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = weighted.Fit([]string{"0,0", "10,0", "10,1"}, []string{"b", "a", "a"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	predicted, err := weighted.Predict([]string{"0.5,0"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predicted[0] != "b" {
+		t.Errorf("Weighted Vote Should Follow The Much Closer Neighbor, Got %s", predicted[0])
+	}
+}
+
+func TestDistance(t *testing.T) {
+	trainedData := map[string][][]float64{
+		"long":  {{10, 0}},
+		"short": {{1, 1}},
+	}
+
+	expected := map[string]string{
+		Cosine:    "long",
+		Euclidean: "short",
+	}
+
+	for distance, expectedClass := range expected {
+		knn, err := NewKNearestNeighbor(KNearestNeighborConfig{
+			Evaluator: pointEvaluator{trainedData: trainedData},
+			K:         1,
+			Distance:  distance,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := knn.Predict([]string{"1,0.1"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if predicted[0] != expectedClass {
+			t.Errorf("%s Distance Should Predict %s, Got %s", distance, expectedClass, predicted[0])
+		}
+	}
+}
+
+func TestConfig(t *testing.T) {
+	_, err := NewKNearestNeighbor(KNearestNeighborConfig{
+		Evaluator: pointEvaluator{},
+		K:         -1,
+	})
+
+	if err == nil || err.Error() != InvalidK {
+		t.Errorf("Negative K Should Return %s", InvalidK)
+	}
+
+	_, err = NewKNearestNeighbor(KNearestNeighborConfig{
+		Evaluator: pointEvaluator{},
+		Distance:  "Manhattan",
+	})
+
+	if err == nil || err.Error() != UnknownDistance {
+		t.Errorf("Unknown Distance Should Return %s", UnknownDistance)
+	}
+
+	_, err = NewKNearestNeighbor(KNearestNeighborConfig{})
+
+	if err == nil || err.Error() != EvaluatorNil {
+		t.Errorf("Nil Evaluator Should Return %s", EvaluatorNil)
+	}
+
+	knn, err := NewKNearestNeighbor(KNearestNeighborConfig{
+		Evaluator: pointEvaluator{},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = knn.Predict([]string{"0,0"})
+
+	if err == nil || err.Error() != ModelNotFitted {
+		t.Errorf("Unfitted Model Should Return %s", ModelNotFitted)
+	}
+}
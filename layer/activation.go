@@ -0,0 +1,69 @@
+package layer
+
+import (
+	"math"
+)
+
+type ActivationFunctionType string
+
+const (
+	Linear  ActivationFunctionType = "linear"
+	ReLU    ActivationFunctionType = "relu"
+	Sigmoid ActivationFunctionType = "sigmoid"
+	Tanh    ActivationFunctionType = "tanh"
+	Softmax ActivationFunctionType = "softmax"
+)
+
+func isActivation(activation ActivationFunctionType) bool {
+	return activation == Linear || activation == ReLU || activation == Sigmoid || activation == Tanh || activation == Softmax
+}
+
+func activate(activation ActivationFunctionType, row []float64) {
+	switch activation {
+	case ReLU:
+		for idx, val := range row {
+			row[idx] = math.Max(val, 0)
+		}
+	case Sigmoid:
+		for idx, val := range row {
+			row[idx] = 1 / (1 + math.Exp(-val))
+		}
+	case Tanh:
+		for idx, val := range row {
+			row[idx] = math.Tanh(val)
+		}
+	case Softmax:
+		highest := math.Inf(-1)
+		for _, val := range row {
+			highest = math.Max(highest, val)
+		}
+
+		denominator := float64(0)
+		for idx, val := range row {
+			row[idx] = math.Exp(val - highest)
+			denominator += row[idx]
+		}
+
+		for idx := range row {
+			row[idx] /= denominator
+		}
+	}
+}
+
+// Derivative is written with the activated value, softmax is only used on the output layer
+// where it is derived together with the cross entropy.
+func Derivative(activation ActivationFunctionType, activated float64) float64 {
+	switch activation {
+	case ReLU:
+		if activated > 0 {
+			return 1
+		}
+		return 0
+	case Sigmoid:
+		return activated * (1 - activated)
+	case Tanh:
+		return 1 - activated*activated
+	}
+
+	return 1
+}
@@ -0,0 +1,118 @@
+package layer
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/helper"
+	"math"
+	"math/rand"
+)
+
+const (
+	InvalidLayerSize  = "Invalid Layer Size"
+	UnknownActivation = "Unknown Activation"
+)
+
+// Layer is a dense layer. Weight has a row for every input and a column for every neuron, Bias
+// holds a value for every neuron and Value keeps the activated output of the last Forward.
+type Layer struct {
+	Value      [][]float64
+	Weight     [][]float64
+	Bias       []float64
+	Activation ActivationFunctionType
+	input      [][]float64
+}
+
+// NewDenseLayer uses the He initialization for ReLU and the Glorot one for the other activations.
+func NewDenseLayer(inputSize int, outputSize int, activation ActivationFunctionType, random *rand.Rand) (*Layer, error) {
+	if inputSize <= 0 || outputSize <= 0 {
+		return nil, errors.New(InvalidLayerSize)
+	}
+
+	if !isActivation(activation) {
+		return nil, errors.New(UnknownActivation)
+	}
+
+	limit := math.Sqrt(6 / float64(inputSize+outputSize))
+	if activation == ReLU {
+		limit = math.Sqrt(6 / float64(inputSize))
+	}
+
+	layer := Layer{
+		Bias:       make([]float64, outputSize),
+		Activation: activation,
+	}
+
+	for i := 0; i < inputSize; i++ {
+		row := make([]float64, outputSize)
+		for j := range row {
+			row[j] = -limit + random.Float64()*2*limit
+		}
+		layer.Weight = append(layer.Weight, row)
+	}
+
+	return &layer, nil
+}
+
+func (l *Layer) Forward(input [][]float64) ([][]float64, error) {
+	output, err := helper.MatrixMultiplication(input, l.Weight)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range output {
+		for idx := range row {
+			row[idx] += l.Bias[idx]
+		}
+		activate(l.Activation, row)
+	}
+
+	l.input = input
+	l.Value = output
+
+	return output, nil
+}
+
+// Backward takes the gradient of the loss with respect to the output before the activation,
+// updates the layer and returns the gradient with respect to its activated input.
+func (l *Layer) Backward(delta [][]float64, learningRate float64, l2 float64) [][]float64 {
+	inputGradient := make([][]float64, len(delta))
+	for row := range delta {
+		inputGradient[row] = make([]float64, len(l.Weight))
+		for i, weight := range l.Weight {
+			for j, val := range delta[row] {
+				inputGradient[row][i] += val * weight[j]
+			}
+		}
+	}
+
+	if l2 != 0 {
+		for i := range l.Weight {
+			for j := range l.Weight[i] {
+				l.Weight[i][j] -= learningRate * l2 * l.Weight[i][j]
+			}
+		}
+	}
+
+	// TF-IDF inputs are mostly zero, so the weight gradient is only added for the non zero inputs.
+	for row := range delta {
+		for i, input := range l.input[row] {
+			if input == 0 {
+				continue
+			}
+			for j, val := range delta[row] {
+				l.Weight[i][j] -= learningRate * input * val
+			}
+		}
+	}
+
+	for j := range l.Bias {
+		gradient := float64(0)
+		for row := range delta {
+			gradient += delta[row][j]
+		}
+		l.Bias[j] -= learningRate * gradient
+	}
+
+	return inputGradient
+}
@@ -0,0 +1,74 @@
+package layer
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestForward(t *testing.T) {
+	layer := Layer{
+		Weight:     [][]float64{{1, 0}, {0, 2}},
+		Bias:       []float64{0.5, -1},
+		Activation: Linear,
+	}
+
+	output, err := layer.Forward([][]float64{{1, 1}, {2, 3}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	expected := [][]float64{{1.5, 1}, {2.5, 5}}
+	for row := range expected {
+		for col := range expected[row] {
+			if output[row][col] != expected[row][col] {
+				t.Errorf("Linear Layer Should Add The Bias Of Every Neuron, Expected %v Got %v", expected, output)
+			}
+		}
+	}
+
+	layer.Activation = ReLU
+	output, err = layer.Forward([][]float64{{-1, 0}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if output[0][0] != 0 || output[0][1] != 0 {
+		t.Errorf("ReLU Should Cut The Negative Output, Got %v", output)
+	}
+
+	if len(layer.input) != 1 || len(layer.Value) != 1 {
+		t.Errorf("Forward Should Keep The Input And The Output For Backward")
+	}
+}
+
+func TestBackward(t *testing.T) {
+	layer := Layer{
+		Weight:     [][]float64{{1}, {2}},
+		Bias:       []float64{0},
+		Activation: Linear,
+	}
+
+	_, err := layer.Forward([][]float64{{1, 0}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	inputGradient := layer.Backward([][]float64{{1}}, 0.5, 0)
+
+	if inputGradient[0][0] != 1 || inputGradient[0][1] != 2 {
+		t.Errorf("Input Gradient Should Be The Delta Times The Weight, Got %v", inputGradient)
+	}
+
+	if layer.Weight[0][0] != 0.5 || layer.Weight[1][0] != 2 || layer.Bias[0] != -0.5 {
+		t.Errorf("Only The Weight Of The Non Zero Input And The Bias Should Move, Got %v %v", layer.Weight, layer.Bias)
+	}
+
+	_, err = NewDenseLayer(2, 0, ReLU, rand.New(rand.NewSource(1)))
+
+	if err == nil || err.Error() != InvalidLayerSize {
+		t.Errorf("Layer Without Neuron Should Return %s", InvalidLayerSize)
+	}
+}
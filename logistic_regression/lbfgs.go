@@ -0,0 +1,150 @@
+package logistic_regression
+
+import (
+	"math"
+)
+
+const (
+	lbfgsHistoryLength  = 10
+	lbfgsMaxLineSearch  = 30
+	lbfgsArmijoConstant = 1e-4
+)
+
+func dot(a, b []float64) float64 {
+	result := float64(0)
+	for idx := range a {
+		result += a[idx] * b[idx]
+	}
+
+	return result
+}
+
+func (lr *LogisticRegression) getParameter() []float64 {
+	var parameter []float64
+	for _, weight := range lr.weight {
+		parameter = append(parameter, weight...)
+	}
+
+	return append(parameter, lr.bias...)
+}
+
+func (lr *LogisticRegression) setParameter(parameter []float64) {
+	position := 0
+	for classIdx := range lr.weight {
+		copy(lr.weight[classIdx], parameter[position:position+len(lr.weight[classIdx])])
+		position += len(lr.weight[classIdx])
+	}
+	copy(lr.bias, parameter[position:])
+}
+
+func (lr *LogisticRegression) flatGradient(inputs [][]float64, targets []int) []float64 {
+	weightGradient, biasGradient := lr.gradient(inputs, targets)
+
+	var gradient []float64
+	for _, weight := range weightGradient {
+		gradient = append(gradient, weight...)
+	}
+
+	return append(gradient, biasGradient...)
+}
+
+func (lr *LogisticRegression) minimizeLBFGS(inputs [][]float64, targets []int, monitor func() bool) {
+	var sHistory, yHistory [][]float64
+	var rhoHistory []float64
+
+	parameter := lr.getParameter()
+	loss := lr.loss(inputs, targets)
+	gradient := lr.flatGradient(inputs, targets)
+
+	for iteration := 0; iteration < lr.maxIteration; iteration++ {
+		// Two loop recursion to approximate the inverse hessian times the gradient.
+		direction := append([]float64{}, gradient...)
+		alpha := make([]float64, len(sHistory))
+		for i := len(sHistory) - 1; i >= 0; i-- {
+			alpha[i] = rhoHistory[i] * dot(sHistory[i], direction)
+			for idx := range direction {
+				direction[idx] -= alpha[i] * yHistory[i][idx]
+			}
+		}
+
+		gamma := float64(1)
+		if last := len(sHistory) - 1; last >= 0 {
+			gamma = dot(sHistory[last], yHistory[last]) / dot(yHistory[last], yHistory[last])
+		}
+
+		for idx := range direction {
+			direction[idx] *= gamma
+		}
+
+		for i := range sHistory {
+			beta := rhoHistory[i] * dot(yHistory[i], direction)
+			for idx := range direction {
+				direction[idx] += sHistory[i][idx] * (alpha[i] - beta)
+			}
+		}
+
+		for idx := range direction {
+			direction[idx] = -direction[idx]
+		}
+
+		slope := dot(gradient, direction)
+		if slope >= 0 {
+			sHistory, yHistory, rhoHistory = nil, nil, nil
+			for idx := range direction {
+				direction[idx] = -gradient[idx]
+			}
+			slope = dot(gradient, direction)
+		}
+
+		if slope == 0 {
+			return
+		}
+
+		step := float64(1)
+		if len(sHistory) == 0 {
+			step = math.Min(1, 1/math.Sqrt(-slope))
+		}
+
+		newParameter := make([]float64, len(parameter))
+		newLoss := loss
+		for lineSearch := 0; lineSearch < lbfgsMaxLineSearch; lineSearch++ {
+			for idx := range parameter {
+				newParameter[idx] = parameter[idx] + step*direction[idx]
+			}
+
+			lr.setParameter(newParameter)
+			newLoss = lr.loss(inputs, targets)
+
+			if newLoss <= loss+lbfgsArmijoConstant*step*slope {
+				break
+			}
+
+			step /= 2
+		}
+
+		newGradient := lr.flatGradient(inputs, targets)
+
+		s := make([]float64, len(parameter))
+		y := make([]float64, len(parameter))
+		for idx := range parameter {
+			s[idx] = newParameter[idx] - parameter[idx]
+			y[idx] = newGradient[idx] - gradient[idx]
+		}
+
+		if sy := dot(s, y); sy > 1e-10 {
+			sHistory = append(sHistory, s)
+			yHistory = append(yHistory, y)
+			rhoHistory = append(rhoHistory, 1/sy)
+
+			if len(sHistory) > lbfgsHistoryLength {
+				sHistory, yHistory, rhoHistory = sHistory[1:], yHistory[1:], rhoHistory[1:]
+			}
+		}
+
+		parameter, loss, gradient = newParameter, newLoss, newGradient
+
+		if monitor() {
+			return
+		}
+	}
+}
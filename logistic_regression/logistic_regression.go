@@ -0,0 +1,461 @@
+package logistic_regression
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/model_selection"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	InvalidDataLearn     = "Invalid Data Learn"
+	UnequalFeatureLength = "Unequal Feature Length"
+	ModelNotFitted       = "Model Not Fitted"
+	EvaluatorNil         = "Evaluator is Nil"
+	UnknownOptimizer     = "Unknown Optimizer"
+	L1NotSupported       = "L1 Regularization Is Not Supported By LBFGS"
+
+	MiniBatchGradientDescent = "MiniBatchGradientDescent"
+	LBFGS                    = "LBFGS"
+
+	DefaultLearningRate = 0.1
+	DefaultBatchSize    = 32
+	DefaultMaxIteration = 100
+	DefaultTolerance    = 1e-4
+	DefaultPatience     = 5
+)
+
+type Evaluator interface {
+	EvaluateInput(input []string) ([][]float64, error)
+}
+
+type LogisticRegressionConfig struct {
+	Evaluator          Evaluator
+	Optimizer          string
+	LearningRate       float64
+	BatchSize          int
+	MaxIteration       int
+	L1                 float64
+	L2                 float64
+	Tolerance          float64
+	Patience           int
+	ValidationFraction float64
+	Seed               int64
+}
+
+type LogisticRegression struct {
+	evaluator          Evaluator
+	optimizer          string
+	learningRate       float64
+	batchSize          int
+	maxIteration       int
+	l1                 float64
+	l2                 float64
+	tolerance          float64
+	patience           int
+	validationFraction float64
+	seed               int64
+	classes            []string
+	weight             [][]float64
+	bias               []float64
+	trainingLoss       []float64
+	validationLoss     []float64
+}
+
+func NewLogisticRegression(cfg LogisticRegressionConfig) (*LogisticRegression, error) {
+	if cfg.Evaluator == nil {
+		return nil, errors.New(EvaluatorNil)
+	}
+
+	if cfg.Optimizer == "" {
+		cfg.Optimizer = MiniBatchGradientDescent
+	}
+
+	if cfg.Optimizer != MiniBatchGradientDescent && cfg.Optimizer != LBFGS {
+		return nil, errors.New(UnknownOptimizer)
+	}
+
+	if cfg.Optimizer == LBFGS && cfg.L1 != 0 {
+		return nil, errors.New(L1NotSupported)
+	}
+
+	if cfg.LearningRate == 0 {
+		cfg.LearningRate = DefaultLearningRate
+	}
+
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+
+	if cfg.MaxIteration == 0 {
+		cfg.MaxIteration = DefaultMaxIteration
+	}
+
+	if cfg.Tolerance == 0 {
+		cfg.Tolerance = DefaultTolerance
+	}
+
+	if cfg.Patience == 0 {
+		cfg.Patience = DefaultPatience
+	}
+
+	return &LogisticRegression{
+		evaluator:          cfg.Evaluator,
+		optimizer:          cfg.Optimizer,
+		learningRate:       cfg.LearningRate,
+		batchSize:          cfg.BatchSize,
+		maxIteration:       cfg.MaxIteration,
+		l1:                 cfg.L1,
+		l2:                 cfg.L2,
+		tolerance:          cfg.Tolerance,
+		patience:           cfg.Patience,
+		validationFraction: cfg.ValidationFraction,
+		seed:               cfg.Seed,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*LogisticRegression)(nil)
+
+func (lr *LogisticRegression) Fit(documents []string, classes []string) error {
+	if len(documents) == 0 || len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	evaluatedInputs, err := lr.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return err
+	}
+
+	return lr.FitVector(evaluatedInputs, classes)
+}
+
+func (lr *LogisticRegression) FitVector(inputs [][]float64, classes []string) error {
+	if len(inputs) == 0 || len(inputs) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	featureLength := len(inputs[0])
+	for _, input := range inputs {
+		if len(input) != featureLength {
+			return errors.New(UnequalFeatureLength)
+		}
+	}
+
+	classIndex := make(map[string]int)
+	lr.classes = nil
+	for _, corpusClass := range classes {
+		if _, exists := classIndex[corpusClass]; !exists {
+			classIndex[corpusClass] = 0
+			lr.classes = append(lr.classes, corpusClass)
+		}
+	}
+	sort.Strings(lr.classes)
+	for idx, corpusClass := range lr.classes {
+		classIndex[corpusClass] = idx
+	}
+
+	targets := make([]int, len(classes))
+	for idx, corpusClass := range classes {
+		targets[idx] = classIndex[corpusClass]
+	}
+
+	trainInputs, trainTargets := inputs, targets
+	var validationInputs [][]float64
+	var validationTargets []int
+	if lr.validationFraction > 0 && len(inputs) > 1 {
+		// The first fold of a stratified split is held out, so the validation data keeps the class ratio.
+		fold := int(math.Round(1 / lr.validationFraction))
+		if fold < 2 {
+			fold = 2
+		}
+		if fold > len(inputs) {
+			fold = len(inputs)
+		}
+
+		folds, err := model_selection.Split(len(inputs), classes, model_selection.CrossValidationConfig{
+			Strategy: model_selection.StratifiedKFold,
+			Fold:     fold,
+			Seed:     lr.seed,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		trainInputs, trainTargets = nil, nil
+		for _, idx := range folds[0].Train {
+			trainInputs = append(trainInputs, inputs[idx])
+			trainTargets = append(trainTargets, targets[idx])
+		}
+		for _, idx := range folds[0].Test {
+			validationInputs = append(validationInputs, inputs[idx])
+			validationTargets = append(validationTargets, targets[idx])
+		}
+	}
+
+	lr.weight = make([][]float64, len(lr.classes))
+	for idx := range lr.weight {
+		lr.weight[idx] = make([]float64, featureLength)
+	}
+	lr.bias = make([]float64, len(lr.classes))
+	lr.trainingLoss = nil
+	lr.validationLoss = nil
+
+	stopping := earlyStopping{
+		tolerance: lr.tolerance,
+		patience:  lr.patience,
+		bestLoss:  math.Inf(1),
+	}
+
+	monitor := func() bool {
+		trainingLoss := lr.loss(trainInputs, trainTargets)
+		lr.trainingLoss = append(lr.trainingLoss, trainingLoss)
+
+		monitoredLoss := trainingLoss
+		if len(validationInputs) > 0 {
+			monitoredLoss = lr.loss(validationInputs, validationTargets)
+			lr.validationLoss = append(lr.validationLoss, monitoredLoss)
+		}
+
+		return stopping.check(monitoredLoss, lr.weight, lr.bias)
+	}
+
+	// The starting weights are the best ones until an iteration improves them, so a fit that never
+	// iterates, like on a single class or a zero gradient, still leaves usable weights.
+	monitor()
+
+	if lr.optimizer == LBFGS {
+		lr.minimizeLBFGS(trainInputs, trainTargets, monitor)
+	} else {
+		lr.minimizeGradientDescent(trainInputs, trainTargets, rand.New(rand.NewSource(lr.seed)), monitor)
+	}
+
+	lr.weight = stopping.bestWeight
+	lr.bias = stopping.bestBias
+
+	return nil
+}
+
+func (lr *LogisticRegression) Predict(documents []string) ([]string, error) {
+	var predicted []string
+	probabilities, err := lr.PredictProbability(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range lr.classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (lr *LogisticRegression) PredictProbability(documents []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := lr.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return lr.PredictVectorProbability(evaluatedInputs)
+}
+
+func (lr *LogisticRegression) PredictVectorProbability(inputs [][]float64) ([]map[string]float64, error) {
+	if len(lr.classes) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	var allPrediction []map[string]float64
+	for _, input := range inputs {
+		if len(input) != len(lr.weight[0]) {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+
+		predictedClass := make(map[string]float64)
+		for idx, prob := range lr.probability(input) {
+			predictedClass[lr.classes[idx]] = prob
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (lr *LogisticRegression) GetClasses() []string {
+	return lr.classes
+}
+
+func (lr *LogisticRegression) GetTrainingLoss() []float64 {
+	return lr.trainingLoss
+}
+
+func (lr *LogisticRegression) GetValidationLoss() []float64 {
+	return lr.validationLoss
+}
+
+func (lr *LogisticRegression) probability(input []float64) []float64 {
+	scores := make([]float64, len(lr.classes))
+	highestScore := math.Inf(-1)
+	for classIdx := range scores {
+		scores[classIdx] = lr.bias[classIdx]
+		for idx, val := range input {
+			if val != 0 {
+				scores[classIdx] += lr.weight[classIdx][idx] * val
+			}
+		}
+		highestScore = math.Max(highestScore, scores[classIdx])
+	}
+
+	denominator := float64(0)
+	for classIdx := range scores {
+		scores[classIdx] = math.Exp(scores[classIdx] - highestScore)
+		denominator += scores[classIdx]
+	}
+
+	for classIdx := range scores {
+		scores[classIdx] /= denominator
+	}
+
+	return scores
+}
+
+func (lr *LogisticRegression) loss(inputs [][]float64, targets []int) float64 {
+	loss := float64(0)
+	for idx, input := range inputs {
+		prob := lr.probability(input)[targets[idx]]
+		loss -= math.Log(math.Max(prob, 1e-15))
+	}
+
+	if len(inputs) > 0 {
+		loss /= float64(len(inputs))
+	}
+
+	for _, weight := range lr.weight {
+		for _, val := range weight {
+			loss += lr.l2/2*val*val + lr.l1*math.Abs(val)
+		}
+	}
+
+	return loss
+}
+
+// gradient of the mean cross entropy plus the L2 penalty, the L1 penalty is handled by the optimizer.
+func (lr *LogisticRegression) gradient(inputs [][]float64, targets []int) ([][]float64, []float64) {
+	weightGradient := make([][]float64, len(lr.weight))
+	for classIdx := range weightGradient {
+		weightGradient[classIdx] = make([]float64, len(lr.weight[classIdx]))
+	}
+	biasGradient := make([]float64, len(lr.bias))
+
+	for idx, input := range inputs {
+		prob := lr.probability(input)
+		for classIdx := range prob {
+			errorValue := prob[classIdx]
+			if classIdx == targets[idx] {
+				errorValue -= 1
+			}
+			errorValue /= float64(len(inputs))
+
+			biasGradient[classIdx] += errorValue
+			for featureIdx, val := range input {
+				if val != 0 {
+					weightGradient[classIdx][featureIdx] += errorValue * val
+				}
+			}
+		}
+	}
+
+	for classIdx := range weightGradient {
+		for featureIdx := range weightGradient[classIdx] {
+			weightGradient[classIdx][featureIdx] += lr.l2 * lr.weight[classIdx][featureIdx]
+		}
+	}
+
+	return weightGradient, biasGradient
+}
+
+func (lr *LogisticRegression) minimizeGradientDescent(inputs [][]float64, targets []int, random *rand.Rand, monitor func() bool) {
+	for iteration := 0; iteration < lr.maxIteration; iteration++ {
+		order := random.Perm(len(inputs))
+
+		for start := 0; start < len(order); start += lr.batchSize {
+			end := start + lr.batchSize
+			if end > len(order) {
+				end = len(order)
+			}
+
+			var batchInputs [][]float64
+			var batchTargets []int
+			for _, idx := range order[start:end] {
+				batchInputs = append(batchInputs, inputs[idx])
+				batchTargets = append(batchTargets, targets[idx])
+			}
+
+			weightGradient, biasGradient := lr.gradient(batchInputs, batchTargets)
+
+			for classIdx := range lr.weight {
+				lr.bias[classIdx] -= lr.learningRate * biasGradient[classIdx]
+				for featureIdx := range lr.weight[classIdx] {
+					val := lr.weight[classIdx][featureIdx] - lr.learningRate*weightGradient[classIdx][featureIdx]
+
+					// Proximal step of the L1 penalty, shrinks small weights to exactly zero.
+					shrink := lr.learningRate * lr.l1
+					if val > shrink {
+						val -= shrink
+					} else if val < -shrink {
+						val += shrink
+					} else {
+						val = 0
+					}
+
+					lr.weight[classIdx][featureIdx] = val
+				}
+			}
+		}
+
+		if monitor() {
+			return
+		}
+	}
+}
+
+type earlyStopping struct {
+	tolerance  float64
+	patience   int
+	bestLoss   float64
+	waiting    int
+	bestWeight [][]float64
+	bestBias   []float64
+}
+
+func (es *earlyStopping) check(loss float64, weight [][]float64, bias []float64) bool {
+	if loss < es.bestLoss-es.tolerance || es.bestWeight == nil {
+		es.bestLoss = math.Min(loss, es.bestLoss)
+		es.waiting = 0
+
+		es.bestWeight = make([][]float64, len(weight))
+		for idx := range weight {
+			es.bestWeight[idx] = append([]float64{}, weight[idx]...)
+		}
+		es.bestBias = append([]float64{}, bias...)
+
+		return false
+	}
+
+	es.waiting++
+
+	return es.waiting >= es.patience
+}
@@ -0,0 +1,200 @@
+package logistic_regression
+
+import (
+	"testing"
+)
+
+type vectorEvaluator struct{}
+
+func (ve vectorEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	var evaluatedInput [][]float64
+	for _, document := range input {
+		vector := make([]float64, 3)
+		for _, character := range document {
+			vector[character-'a'] += 1
+		}
+		evaluatedInput = append(evaluatedInput, vector)
+	}
+	return evaluatedInput, nil
+}
+
+func TestBasic(t *testing.T) {
+	documents := []string{"a", "aa", "ab", "b", "bb", "bc", "c", "cc", "ca"}
+	classes := []string{"x", "x", "x", "y", "y", "y", "z", "z", "z"}
+
+	for _, optimizer := range []string{MiniBatchGradientDescent, LBFGS} {
+		lr, err := NewLogisticRegression(LogisticRegressionConfig{
+			Evaluator:    vectorEvaluator{},
+			Optimizer:    optimizer,
+			L2:           0.01,
+			LearningRate: 0.5,
+			BatchSize:    3,
+			MaxIteration: 200,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = lr.Fit(documents, classes)
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := lr.Predict([]string{"aaa", "bbb", "ccc"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx, expected := range []string{"x", "y", "z"} {
+			if predicted[idx] != expected {
+				t.Errorf("%s Prediction Should Be %s", optimizer, expected)
+			}
+		}
+
+		probabilities, err := lr.PredictProbability([]string{"abc"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		sum := float64(0)
+		for _, prob := range probabilities[0] {
+			sum += prob
+		}
+
+		if sum < 0.999999 || sum > 1.000001 {
+			t.Errorf("%s Probability Should Sum To 1", optimizer)
+		}
+	}
+
+	_, err := NewLogisticRegression(LogisticRegressionConfig{
+		Evaluator: vectorEvaluator{},
+		Optimizer: LBFGS,
+		L1:        0.1,
+	})
+
+	if err == nil || err.Error() != L1NotSupported {
+		t.Errorf("LBFGS With L1 Should Return %s", L1NotSupported)
+	}
+}
+
+func TestFitWithoutIteration(t *testing.T) {
+	testCases := []struct {
+		inputs  [][]float64
+		classes []string
+	}{
+		{[][]float64{{0, 0}, {0, 0}}, []string{"x", "y"}},
+		{[][]float64{{1, 0}, {0, 1}}, []string{"x", "x"}},
+	}
+
+	for _, optimizer := range []string{MiniBatchGradientDescent, LBFGS} {
+		for _, testCase := range testCases {
+			lr, err := NewLogisticRegression(LogisticRegressionConfig{
+				Evaluator: vectorEvaluator{},
+				Optimizer: optimizer,
+			})
+
+			if err != nil {
+				panic(err)
+			}
+
+			err = lr.FitVector(testCase.inputs, testCase.classes)
+
+			if err != nil {
+				panic(err)
+			}
+
+			probabilities, err := lr.PredictVectorProbability([][]float64{{0, 0}})
+
+			if err != nil {
+				t.Errorf("%s Should Predict After Fitting %v, Got %s", optimizer, testCase.classes, err)
+				continue
+			}
+
+			sum := float64(0)
+			for _, prob := range probabilities[0] {
+				sum += prob
+			}
+
+			if sum < 0.999999 || sum > 1.000001 {
+				t.Errorf("%s Probability Should Sum To 1 After Fitting %v", optimizer, testCase.classes)
+			}
+		}
+	}
+}
+
+func TestL1Regularization(t *testing.T) {
+	// Only the first feature tells the classes apart, the second one is noise.
+	documents := []string{"a", "aa", "ab", "b", "bb", "ab", "c", "cb", "cc", "ca"}
+	classes := []string{"x", "x", "x", "y", "y", "y", "z", "z", "z", "z"}
+
+	lr, err := NewLogisticRegression(LogisticRegressionConfig{
+		Evaluator:    vectorEvaluator{},
+		L1:           0.5,
+		LearningRate: 0.1,
+		BatchSize:    10,
+		MaxIteration: 200,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = lr.Fit(documents, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	zero := 0
+	for _, weight := range lr.weight {
+		for _, val := range weight {
+			if val == 0 {
+				zero++
+			}
+		}
+	}
+
+	if zero == 0 {
+		t.Errorf("L1 Should Shrink Some Weights To Exactly Zero, Got %v", lr.weight)
+	}
+}
+
+func TestEarlyStopping(t *testing.T) {
+	documents := []string{"a", "aa", "ab", "ba", "b", "bb", "ba", "ab", "c", "cc", "ca", "ac"}
+	classes := []string{"x", "x", "x", "x", "y", "y", "y", "y", "z", "z", "z", "z"}
+
+	for _, optimizer := range []string{MiniBatchGradientDescent, LBFGS} {
+		lr, err := NewLogisticRegression(LogisticRegressionConfig{
+			Evaluator:          vectorEvaluator{},
+			Optimizer:          optimizer,
+			LearningRate:       0.5,
+			BatchSize:          4,
+			MaxIteration:       1000,
+			Patience:           3,
+			ValidationFraction: 0.25,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = lr.Fit(documents, classes)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if len(lr.GetValidationLoss()) == 0 {
+			t.Errorf("%s Should Record The Validation Loss", optimizer)
+		}
+
+		// The starting weights are monitored too, so a fit that never stops records 1001 losses.
+		if len(lr.GetTrainingLoss()) > 1000 {
+			t.Errorf("%s Should Stop Before The Max Iteration, Got %d Iterations", optimizer, len(lr.GetTrainingLoss())-1)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ClassMetric struct {
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+	Support   int     `json:"support"`
+}
+
+type ClassificationReport struct {
+	Labels          []string               `json:"labels"`
+	Classes         map[string]ClassMetric `json:"classes"`
+	Accuracy        float64                `json:"accuracy"`
+	MacroAverage    ClassMetric            `json:"macro_average"`
+	MicroAverage    ClassMetric            `json:"micro_average"`
+	WeightedAverage ClassMetric            `json:"weighted_average"`
+	CohenKappa      float64                `json:"cohen_kappa"`
+	ConfusionMatrix *ConfusionMatrix       `json:"confusion_matrix"`
+}
+
+func divide(numerator float64, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return numerator / denominator
+}
+
+func getF1(precision float64, recall float64) float64 {
+	return divide(2*precision*recall, precision+recall)
+}
+
+func Accuracy(trueLabels []string, predictedLabels []string) (float64, error) {
+	cm, err := NewConfusionMatrix(trueLabels, predictedLabels)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return cm.getAccuracy(), nil
+}
+
+// CohenKappa is the agreement between the true and the predicted labels corrected by the
+// agreement expected by chance.
+func CohenKappa(trueLabels []string, predictedLabels []string) (float64, error) {
+	cm, err := NewConfusionMatrix(trueLabels, predictedLabels)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return cm.getCohenKappa(), nil
+}
+
+func (cm *ConfusionMatrix) getAccuracy() float64 {
+	correct := 0
+	for idx := range cm.Labels {
+		correct += cm.Matrix[idx][idx]
+	}
+
+	return divide(float64(correct), float64(cm.GetTotal()))
+}
+
+func (cm *ConfusionMatrix) getCohenKappa() float64 {
+	total := float64(cm.GetTotal())
+
+	expected := float64(0)
+	for idx := range cm.Labels {
+		trueCount := 0
+		predictedCount := 0
+		for other := range cm.Labels {
+			trueCount += cm.Matrix[idx][other]
+			predictedCount += cm.Matrix[other][idx]
+		}
+		expected += float64(trueCount) / total * float64(predictedCount) / total
+	}
+
+	// Both sides only hold the same single label.
+	if expected == 1 {
+		return 1
+	}
+
+	return (cm.getAccuracy() - expected) / (1 - expected)
+}
+
+func NewClassificationReport(trueLabels []string, predictedLabels []string) (*ClassificationReport, error) {
+	cm, err := NewConfusionMatrix(trueLabels, predictedLabels)
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := ClassificationReport{
+		Labels:          cm.Labels,
+		Classes:         make(map[string]ClassMetric),
+		Accuracy:        cm.getAccuracy(),
+		CohenKappa:      cm.getCohenKappa(),
+		ConfusionMatrix: cm,
+	}
+
+	total := cm.GetTotal()
+	var truePositive, falsePositive, falseNegative int
+	for idx, label := range cm.Labels {
+		predictedCount := 0
+		support := 0
+		for other := range cm.Labels {
+			predictedCount += cm.Matrix[other][idx]
+			support += cm.Matrix[idx][other]
+		}
+
+		correct := cm.Matrix[idx][idx]
+		truePositive += correct
+		falsePositive += predictedCount - correct
+		falseNegative += support - correct
+
+		metric := ClassMetric{
+			Precision: divide(float64(correct), float64(predictedCount)),
+			Recall:    divide(float64(correct), float64(support)),
+			Support:   support,
+		}
+		metric.F1 = getF1(metric.Precision, metric.Recall)
+		report.Classes[label] = metric
+
+		report.MacroAverage.Precision += metric.Precision / float64(len(cm.Labels))
+		report.MacroAverage.Recall += metric.Recall / float64(len(cm.Labels))
+		report.MacroAverage.F1 += metric.F1 / float64(len(cm.Labels))
+
+		weight := float64(support) / float64(total)
+		report.WeightedAverage.Precision += metric.Precision * weight
+		report.WeightedAverage.Recall += metric.Recall * weight
+		report.WeightedAverage.F1 += metric.F1 * weight
+	}
+
+	report.MicroAverage.Precision = divide(float64(truePositive), float64(truePositive+falsePositive))
+	report.MicroAverage.Recall = divide(float64(truePositive), float64(truePositive+falseNegative))
+	report.MicroAverage.F1 = getF1(report.MicroAverage.Precision, report.MicroAverage.Recall)
+
+	report.MacroAverage.Support = total
+	report.MicroAverage.Support = total
+	report.WeightedAverage.Support = total
+
+	return &report, nil
+}
+
+func (r *ClassificationReport) String() string {
+	width := len("weighted avg")
+	for _, label := range r.Labels {
+		if len(label) > width {
+			width = len(label)
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-*s %10s %10s %10s %10s\n", width, "", "precision", "recall", "f1-score", "support"))
+	builder.WriteString("\n")
+
+	writeMetric := func(name string, metric ClassMetric) {
+		builder.WriteString(fmt.Sprintf("%-*s %10.4f %10.4f %10.4f %10d\n",
+			width, name, metric.Precision, metric.Recall, metric.F1, metric.Support))
+	}
+
+	for _, label := range r.Labels {
+		writeMetric(label, r.Classes[label])
+	}
+
+	builder.WriteString("\n")
+	builder.WriteString(fmt.Sprintf("%-*s %10s %10s %10.4f %10d\n", width, "accuracy", "", "", r.Accuracy, r.MicroAverage.Support))
+	writeMetric("macro avg", r.MacroAverage)
+	writeMetric("micro avg", r.MicroAverage)
+	writeMetric("weighted avg", r.WeightedAverage)
+	builder.WriteString(fmt.Sprintf("%-*s %10s %10s %10.4f\n", width, "cohen kappa", "", "", r.CohenKappa))
+
+	return builder.String()
+}
+
+func (r *ClassificationReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func isClose(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestClassificationReport(t *testing.T) {
+	trueLabels := []string{"cat", "cat", "cat", "dog", "dog", "bird"}
+	predictedLabels := []string{"cat", "cat", "dog", "dog", "cat", "bird"}
+
+	report, err := NewClassificationReport(trueLabels, predictedLabels)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if report.ConfusionMatrix.Get("cat", "dog") != 1 || report.ConfusionMatrix.Get("dog", "cat") != 1 {
+		t.Errorf("Confusion Matrix Should Count Every Mistake")
+	}
+
+	cat := report.Classes["cat"]
+	if !isClose(cat.Precision, 2.0/3) || !isClose(cat.Recall, 2.0/3) || cat.Support != 3 {
+		t.Errorf("Cat Should Have Precision 2/3, Recall 2/3 And Support 3, Got %+v", cat)
+	}
+
+	dog := report.Classes["dog"]
+	if !isClose(dog.Precision, 0.5) || !isClose(dog.Recall, 0.5) || !isClose(dog.F1, 0.5) {
+		t.Errorf("Dog Should Have Precision, Recall And F1 Of 0.5, Got %+v", dog)
+	}
+
+	if !isClose(report.Accuracy, 4.0/6) {
+		t.Errorf("Accuracy Should Be 4/6, Got %f", report.Accuracy)
+	}
+
+	if !isClose(report.MicroAverage.F1, report.Accuracy) {
+		t.Errorf("Micro F1 Should Equal The Accuracy For Single Label Data")
+	}
+
+	if !isClose(report.MacroAverage.Recall, (2.0/3+0.5+1)/3) {
+		t.Errorf("Macro Recall Should Be The Mean Of The Class Recall, Got %f", report.MacroAverage.Recall)
+	}
+
+	if !isClose(report.WeightedAverage.Recall, report.Accuracy) {
+		t.Errorf("Weighted Recall Should Equal The Accuracy, Got %f", report.WeightedAverage.Recall)
+	}
+
+	// Chance agreement is (3*3 + 2*2 + 1*1) / 36.
+	expected := 14.0 / 36
+	if !isClose(report.CohenKappa, (4.0/6-expected)/(1-expected)) {
+		t.Errorf("Cohen Kappa Is Wrong, Got %f", report.CohenKappa)
+	}
+
+	encoded, err := report.JSON()
+
+	if err != nil {
+		panic(err)
+	}
+
+	var decoded ClassificationReport
+	err = json.Unmarshal(encoded, &decoded)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if decoded.Classes["dog"].Support != 2 {
+		t.Errorf("Report Should Survive A JSON Round Trip")
+	}
+
+	if report.String() == "" || report.ConfusionMatrix.String() == "" {
+		t.Errorf("Report Should Be Printable")
+	}
+
+	_, err = Accuracy([]string{"a"}, []string{"a", "b"})
+
+	if err == nil || err.Error() != UnequalLength {
+		t.Errorf("Labels Of Another Length Should Return %s", UnequalLength)
+	}
+
+	accuracy, err := Accuracy([]string{"a", "b", "c"}, []string{"a", "b", "b"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !isClose(accuracy, 2.0/3) {
+		t.Errorf("Accuracy Should Count The Last Element, Got %f", accuracy)
+	}
+}
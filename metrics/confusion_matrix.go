@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	UnequalLength = "Unequal Length"
+	EmptyData     = "Empty Data"
+)
+
+// ConfusionMatrix has a row for every true label and a column for every predicted label, both in
+// the order of Labels.
+type ConfusionMatrix struct {
+	Labels []string `json:"labels"`
+	Matrix [][]int  `json:"matrix"`
+	index  map[string]int
+}
+
+// NewConfusionMatrix takes the labels seen on either side, sorted by name.
+func NewConfusionMatrix(trueLabels []string, predictedLabels []string) (*ConfusionMatrix, error) {
+	if len(trueLabels) != len(predictedLabels) {
+		return nil, errors.New(UnequalLength)
+	}
+
+	if len(trueLabels) == 0 {
+		return nil, errors.New(EmptyData)
+	}
+
+	cm := ConfusionMatrix{
+		index: make(map[string]int),
+	}
+
+	for _, labels := range [][]string{trueLabels, predictedLabels} {
+		for _, label := range labels {
+			if _, exists := cm.index[label]; !exists {
+				cm.index[label] = 0
+				cm.Labels = append(cm.Labels, label)
+			}
+		}
+	}
+	sort.Strings(cm.Labels)
+	for idx, label := range cm.Labels {
+		cm.index[label] = idx
+	}
+
+	cm.Matrix = make([][]int, len(cm.Labels))
+	for idx := range cm.Matrix {
+		cm.Matrix[idx] = make([]int, len(cm.Labels))
+	}
+
+	for idx := range trueLabels {
+		cm.Matrix[cm.index[trueLabels[idx]]][cm.index[predictedLabels[idx]]]++
+	}
+
+	return &cm, nil
+}
+
+func (cm *ConfusionMatrix) Get(trueLabel string, predictedLabel string) int {
+	trueIdx, trueExists := cm.index[trueLabel]
+	predictedIdx, predictedExists := cm.index[predictedLabel]
+
+	if !trueExists || !predictedExists {
+		return 0
+	}
+
+	return cm.Matrix[trueIdx][predictedIdx]
+}
+
+func (cm *ConfusionMatrix) GetTotal() int {
+	total := 0
+	for _, row := range cm.Matrix {
+		for _, count := range row {
+			total += count
+		}
+	}
+
+	return total
+}
+
+func (cm *ConfusionMatrix) String() string {
+	header := "true \\ predicted"
+	labelWidth := len(header)
+	width := 0
+	for _, label := range cm.Labels {
+		if len(label) > labelWidth {
+			labelWidth = len(label)
+		}
+		if len(label) > width {
+			width = len(label)
+		}
+	}
+
+	for _, row := range cm.Matrix {
+		for _, count := range row {
+			if len(fmt.Sprint(count)) > width {
+				width = len(fmt.Sprint(count))
+			}
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-*s", labelWidth, header))
+	for _, label := range cm.Labels {
+		builder.WriteString(fmt.Sprintf(" %*s", width, label))
+	}
+	builder.WriteString("\n")
+
+	for rowIdx, row := range cm.Matrix {
+		builder.WriteString(fmt.Sprintf("%-*s", labelWidth, cm.Labels[rowIdx]))
+		for _, count := range row {
+			builder.WriteString(fmt.Sprintf(" %*d", width, count))
+		}
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
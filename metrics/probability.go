@@ -0,0 +1,297 @@
+package metrics
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	InvalidBinCount   = "Invalid Bin Count"
+	SingleClassLabels = "Labels Only Hold A Single Class"
+
+	DefaultBinCount = 10
+)
+
+type ReliabilityBin struct {
+	Lower      float64 `json:"lower"`
+	Upper      float64 `json:"upper"`
+	Confidence float64 `json:"confidence"`
+	Accuracy   float64 `json:"accuracy"`
+	Count      int     `json:"count"`
+}
+
+// ROCCurve holds a point for every distinct threshold, from the highest threshold to the lowest.
+type ROCCurve struct {
+	FalsePositiveRate []float64 `json:"false_positive_rate"`
+	TruePositiveRate  []float64 `json:"true_positive_rate"`
+	Threshold         []float64 `json:"threshold"`
+	AUC               float64   `json:"auc"`
+}
+
+// PrecisionRecallCurve holds a point for every distinct threshold, AUC is the trapezoidal area
+// while AveragePrecision sums the precision weighted by the increase of the recall.
+type PrecisionRecallCurve struct {
+	Precision        []float64 `json:"precision"`
+	Recall           []float64 `json:"recall"`
+	Threshold        []float64 `json:"threshold"`
+	AUC              float64   `json:"auc"`
+	AveragePrecision float64   `json:"average_precision"`
+}
+
+func validateProbability(trueLabels []string, probabilities []map[string]float64) error {
+	if len(trueLabels) != len(probabilities) {
+		return errors.New(UnequalLength)
+	}
+
+	if len(trueLabels) == 0 {
+		return errors.New(EmptyData)
+	}
+
+	return nil
+}
+
+// LogLoss is the mean negative log probability given to the true label, clipped at 1e-15.
+func LogLoss(trueLabels []string, probabilities []map[string]float64) (float64, error) {
+	err := validateProbability(trueLabels, probabilities)
+
+	if err != nil {
+		return 0, err
+	}
+
+	loss := float64(0)
+	for idx, prob := range probabilities {
+		loss -= math.Log(math.Min(math.Max(prob[trueLabels[idx]], 1e-15), 1))
+	}
+
+	return loss / float64(len(trueLabels)), nil
+}
+
+// BrierScore is the mean squared distance between the probabilities and the one hot true label,
+// summed over the classes.
+func BrierScore(trueLabels []string, probabilities []map[string]float64) (float64, error) {
+	err := validateProbability(trueLabels, probabilities)
+
+	if err != nil {
+		return 0, err
+	}
+
+	score := float64(0)
+	for idx, prob := range probabilities {
+		score += math.Pow(1-prob[trueLabels[idx]], 2)
+		for label, val := range prob {
+			if label != trueLabels[idx] {
+				score += val * val
+			}
+		}
+	}
+
+	return score / float64(len(trueLabels)), nil
+}
+
+func getTopLabel(prob map[string]float64) (string, float64) {
+	var labels []string
+	for label := range prob {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	topLabel := ""
+	topProb := float64(-1)
+	for _, label := range labels {
+		if topProb < prob[label] {
+			topLabel = label
+			topProb = prob[label]
+		}
+	}
+
+	return topLabel, topProb
+}
+
+// ReliabilityDiagram bins the samples by the probability of their predicted label, a well
+// calibrated model has an accuracy close to the confidence in every bin.
+func ReliabilityDiagram(trueLabels []string, probabilities []map[string]float64, binCount int) ([]ReliabilityBin, error) {
+	err := validateProbability(trueLabels, probabilities)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if binCount == 0 {
+		binCount = DefaultBinCount
+	}
+
+	if binCount < 0 {
+		return nil, errors.New(InvalidBinCount)
+	}
+
+	bins := make([]ReliabilityBin, binCount)
+	for idx := range bins {
+		bins[idx].Lower = float64(idx) / float64(binCount)
+		bins[idx].Upper = float64(idx+1) / float64(binCount)
+	}
+
+	for idx, prob := range probabilities {
+		label, confidence := getTopLabel(prob)
+
+		binIdx := int(confidence * float64(binCount))
+		if binIdx >= binCount {
+			binIdx = binCount - 1
+		}
+		if binIdx < 0 {
+			binIdx = 0
+		}
+
+		bins[binIdx].Count++
+		bins[binIdx].Confidence += confidence
+		if label == trueLabels[idx] {
+			bins[binIdx].Accuracy += 1
+		}
+	}
+
+	for idx := range bins {
+		if bins[idx].Count > 0 {
+			bins[idx].Confidence /= float64(bins[idx].Count)
+			bins[idx].Accuracy /= float64(bins[idx].Count)
+		}
+	}
+
+	return bins, nil
+}
+
+// ExpectedCalibrationError is the gap between the accuracy and the confidence of every bin,
+// weighted by the samples inside the bin.
+func ExpectedCalibrationError(bins []ReliabilityBin) float64 {
+	total := 0
+	calibrationError := float64(0)
+	for _, bin := range bins {
+		total += bin.Count
+		calibrationError += float64(bin.Count) * math.Abs(bin.Accuracy-bin.Confidence)
+	}
+
+	return divide(calibrationError, float64(total))
+}
+
+type rankedSample struct {
+	score    float64
+	positive bool
+}
+
+// getRankedCounts walks the samples from the highest probability of the positive label and
+// returns the true and false positive counts after every distinct threshold.
+func getRankedCounts(trueLabels []string, probabilities []map[string]float64, positive string) ([]float64, []float64, []float64, error) {
+	err := validateProbability(trueLabels, probabilities)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var samples []rankedSample
+	positiveCount := 0
+	for idx, prob := range probabilities {
+		samples = append(samples, rankedSample{
+			score:    prob[positive],
+			positive: trueLabels[idx] == positive,
+		})
+		if trueLabels[idx] == positive {
+			positiveCount++
+		}
+	}
+
+	if positiveCount == 0 || positiveCount == len(samples) {
+		return nil, nil, nil, errors.New(SingleClassLabels)
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].score > samples[j].score
+	})
+
+	var truePositive, falsePositive, threshold []float64
+	tp, fp := float64(0), float64(0)
+	for idx, s := range samples {
+		if s.positive {
+			tp++
+		} else {
+			fp++
+		}
+
+		if idx == len(samples)-1 || samples[idx+1].score != s.score {
+			truePositive = append(truePositive, tp)
+			falsePositive = append(falsePositive, fp)
+			threshold = append(threshold, s.score)
+		}
+	}
+
+	return truePositive, falsePositive, threshold, nil
+}
+
+// NewROCCurve treats positive as the positive label and every other label as negative.
+func NewROCCurve(trueLabels []string, probabilities []map[string]float64, positive string) (*ROCCurve, error) {
+	truePositive, falsePositive, threshold, err := getRankedCounts(trueLabels, probabilities, positive)
+
+	if err != nil {
+		return nil, err
+	}
+
+	positiveCount := truePositive[len(truePositive)-1]
+	negativeCount := falsePositive[len(falsePositive)-1]
+
+	curve := ROCCurve{
+		FalsePositiveRate: []float64{0},
+		TruePositiveRate:  []float64{0},
+		Threshold:         []float64{math.Inf(1)},
+	}
+
+	for idx := range threshold {
+		curve.FalsePositiveRate = append(curve.FalsePositiveRate, falsePositive[idx]/negativeCount)
+		curve.TruePositiveRate = append(curve.TruePositiveRate, truePositive[idx]/positiveCount)
+		curve.Threshold = append(curve.Threshold, threshold[idx])
+	}
+
+	curve.AUC = getTrapezoidArea(curve.FalsePositiveRate, curve.TruePositiveRate)
+
+	return &curve, nil
+}
+
+// NewPrecisionRecallCurve treats positive as the positive label and every other label as negative.
+func NewPrecisionRecallCurve(trueLabels []string, probabilities []map[string]float64, positive string) (*PrecisionRecallCurve, error) {
+	truePositive, falsePositive, threshold, err := getRankedCounts(trueLabels, probabilities, positive)
+
+	if err != nil {
+		return nil, err
+	}
+
+	positiveCount := truePositive[len(truePositive)-1]
+
+	curve := PrecisionRecallCurve{
+		Precision: []float64{1},
+		Recall:    []float64{0},
+		Threshold: []float64{math.Inf(1)},
+	}
+
+	previousRecall := float64(0)
+	for idx := range threshold {
+		precision := truePositive[idx] / (truePositive[idx] + falsePositive[idx])
+		recall := truePositive[idx] / positiveCount
+
+		curve.Precision = append(curve.Precision, precision)
+		curve.Recall = append(curve.Recall, recall)
+		curve.Threshold = append(curve.Threshold, threshold[idx])
+
+		curve.AveragePrecision += (recall - previousRecall) * precision
+		previousRecall = recall
+	}
+
+	curve.AUC = getTrapezoidArea(curve.Recall, curve.Precision)
+
+	return &curve, nil
+}
+
+func getTrapezoidArea(x []float64, y []float64) float64 {
+	area := float64(0)
+	for idx := 1; idx < len(x); idx++ {
+		area += (x[idx] - x[idx-1]) * (y[idx] + y[idx-1]) / 2
+	}
+
+	return area
+}
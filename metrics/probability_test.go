@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+)
+
+func getBinaryProbability(scores []float64) []map[string]float64 {
+	var probabilities []map[string]float64
+	for _, score := range scores {
+		probabilities = append(probabilities, map[string]float64{
+			"spam": score,
+			"ham":  1 - score,
+		})
+	}
+	return probabilities
+}
+
+func TestLogLossAndBrierScore(t *testing.T) {
+	trueLabels := []string{"spam", "ham"}
+	probabilities := getBinaryProbability([]float64{0.8, 0.4})
+
+	logLoss, err := LogLoss(trueLabels, probabilities)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !isClose(logLoss, -(math.Log(0.8)+math.Log(0.6))/2) {
+		t.Errorf("Log Loss Should Average The Negative Log Probability Of The True Label, Got %f", logLoss)
+	}
+
+	brierScore, err := BrierScore(trueLabels, probabilities)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !isClose(brierScore, 0.2) {
+		t.Errorf("Brier Score Should Be 0.2, Got %f", brierScore)
+	}
+
+	_, err = LogLoss(trueLabels, probabilities[0:1])
+
+	if err == nil || err.Error() != UnequalLength {
+		t.Errorf("Log Loss Should Reject Unequal Length")
+	}
+}
+
+func TestReliabilityDiagram(t *testing.T) {
+	trueLabels := []string{"spam", "spam", "ham", "spam"}
+	probabilities := getBinaryProbability([]float64{0.95, 0.9, 0.85, 0.3})
+
+	bins, err := ReliabilityDiagram(trueLabels, probabilities, 5)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bins) != 5 {
+		t.Errorf("Reliability Diagram Should Have 5 Bins, Got %d", len(bins))
+	}
+
+	// The last sample is predicted as ham with a confidence of 0.7 and lands in the fourth bin.
+	if bins[4].Count != 3 || !isClose(bins[4].Accuracy, 2.0/3) || !isClose(bins[4].Confidence, 0.9) {
+		t.Errorf("Last Bin Should Hold 3 Samples With Accuracy 2/3 And Confidence 0.9, Got %+v", bins[4])
+	}
+
+	if bins[3].Count != 1 || bins[3].Accuracy != 0 {
+		t.Errorf("Fourth Bin Should Hold The Wrong Ham Prediction, Got %+v", bins[3])
+	}
+
+	calibrationError := ExpectedCalibrationError(bins)
+	if !isClose(calibrationError, (3*(0.9-2.0/3)+0.7)/4) {
+		t.Errorf("Expected Calibration Error Should Weight Every Bin By Its Count, Got %f", calibrationError)
+	}
+
+	_, err = ReliabilityDiagram(trueLabels, probabilities, -1)
+
+	if err == nil || err.Error() != InvalidBinCount {
+		t.Errorf("Reliability Diagram Should Reject A Negative Bin Count")
+	}
+}
+
+func TestROCAndPrecisionRecallCurve(t *testing.T) {
+	trueLabels := []string{"spam", "ham", "spam", "ham"}
+	probabilities := getBinaryProbability([]float64{0.9, 0.6, 0.4, 0.1})
+
+	roc, err := NewROCCurve(trueLabels, probabilities, "spam")
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !isClose(roc.AUC, 0.75) {
+		t.Errorf("ROC AUC Should Be 0.75, Got %f", roc.AUC)
+	}
+
+	last := len(roc.Threshold) - 1
+	if len(roc.Threshold) != 5 || roc.FalsePositiveRate[last] != 1 || roc.TruePositiveRate[last] != 1 {
+		t.Errorf("ROC Curve Should End At (1, 1) After Every Threshold, Got %+v", roc)
+	}
+
+	pr, err := NewPrecisionRecallCurve(trueLabels, probabilities, "spam")
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !isClose(pr.AveragePrecision, 0.5+0.5*2.0/3) {
+		t.Errorf("Average Precision Should Be 5/6, Got %f", pr.AveragePrecision)
+	}
+
+	if pr.Recall[len(pr.Recall)-1] != 1 || !isClose(pr.Precision[len(pr.Precision)-1], 0.5) {
+		t.Errorf("Precision Recall Curve Should End At Full Recall, Got %+v", pr)
+	}
+
+	_, err = NewROCCurve([]string{"spam", "spam"}, getBinaryProbability([]float64{0.9, 0.1}), "spam")
+
+	if err == nil || err.Error() != SingleClassLabels {
+		t.Errorf("ROC Curve Should Reject Labels With A Single Class")
+	}
+}
@@ -0,0 +1,345 @@
+package model_selection
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/metrics"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+const (
+	InvalidDataLearn = "Invalid Data Learn"
+	InvalidFold      = "Invalid Fold"
+	UnequalLength    = "Unequal Length"
+	UnknownStrategy  = "Unknown Strategy"
+
+	KFold           = "KFold"
+	StratifiedKFold = "StratifiedKFold"
+	LeaveOneOut     = "LeaveOneOut"
+
+	DefaultFold = 5
+)
+
+// CrossValidationConfig shuffles the data with Seed before every repeat of KFold and
+// StratifiedKFold, the repeat r uses the seed Seed + r. LeaveOneOut is never repeated.
+type CrossValidationConfig struct {
+	Strategy string
+	Fold     int
+	Repeat   int
+	Seed     int64
+	Worker   int
+}
+
+type Fold struct {
+	Repeat int
+	Index  int
+	Train  []int
+	Test   []int
+}
+
+type FoldResult struct {
+	Fold      Fold
+	Predicted []string
+	Report    *metrics.ClassificationReport
+}
+
+type Score struct {
+	Mean float64
+	Std  float64
+}
+
+// CrossValidationResult gives the mean and the standard deviation of every fold, Report is built
+// from the predictions of every fold together.
+type CrossValidationResult struct {
+	Folds      []FoldResult
+	Accuracy   Score
+	MacroF1    Score
+	WeightedF1 Score
+	CohenKappa Score
+	Report     *metrics.ClassificationReport
+}
+
+// Evaluate trains a model on the train data and returns the true and the predicted labels of the
+// test data, a sample may hold more than one label like the tags of a sentence.
+type Evaluate[X any] func(train []X, test []X) ([]string, []string, error)
+
+func setDefault(cfg CrossValidationConfig) (CrossValidationConfig, error) {
+	if cfg.Strategy == "" {
+		cfg.Strategy = StratifiedKFold
+	}
+
+	if cfg.Strategy != KFold && cfg.Strategy != StratifiedKFold && cfg.Strategy != LeaveOneOut {
+		return cfg, errors.New(UnknownStrategy)
+	}
+
+	if cfg.Fold == 0 {
+		cfg.Fold = DefaultFold
+	}
+
+	if cfg.Repeat <= 0 || cfg.Strategy == LeaveOneOut {
+		cfg.Repeat = 1
+	}
+
+	if cfg.Worker <= 0 {
+		cfg.Worker = runtime.NumCPU()
+	}
+
+	return cfg, nil
+}
+
+// Split returns the folds of every repeat, labels are only needed by StratifiedKFold.
+func Split(size int, labels []string, cfg CrossValidationConfig) ([]Fold, error) {
+	cfg, err := setDefault(cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Strategy == LeaveOneOut {
+		cfg.Fold = size
+	}
+
+	if cfg.Fold < 2 || cfg.Fold > size {
+		return nil, errors.New(InvalidFold)
+	}
+
+	if cfg.Strategy == StratifiedKFold && len(labels) != size {
+		return nil, errors.New(UnequalLength)
+	}
+
+	var folds []Fold
+	for repeat := 0; repeat < cfg.Repeat; repeat++ {
+		random := rand.New(rand.NewSource(cfg.Seed + int64(repeat)))
+
+		assignment := make([]int, size)
+		switch cfg.Strategy {
+		case LeaveOneOut:
+			for idx := range assignment {
+				assignment[idx] = idx
+			}
+		case KFold:
+			for position, idx := range random.Perm(size) {
+				assignment[idx] = position % cfg.Fold
+			}
+		case StratifiedKFold:
+			// Every class is dealt to the folds in turn, so each fold keeps the class ratio.
+			groups := make(map[string][]int)
+			var classes []string
+			for idx, label := range labels {
+				if _, exists := groups[label]; !exists {
+					classes = append(classes, label)
+				}
+				groups[label] = append(groups[label], idx)
+			}
+			sort.Strings(classes)
+
+			position := 0
+			for _, label := range classes {
+				group := groups[label]
+				for _, groupIdx := range random.Perm(len(group)) {
+					assignment[group[groupIdx]] = position % cfg.Fold
+					position++
+				}
+			}
+		}
+
+		for foldIdx := 0; foldIdx < cfg.Fold; foldIdx++ {
+			fold := Fold{
+				Repeat: repeat,
+				Index:  foldIdx,
+			}
+
+			for idx, assigned := range assignment {
+				if assigned == foldIdx {
+					fold.Test = append(fold.Test, idx)
+				} else {
+					fold.Train = append(fold.Train, idx)
+				}
+			}
+
+			folds = append(folds, fold)
+		}
+	}
+
+	return folds, nil
+}
+
+// CrossValidateFunc runs evaluate on every fold with Worker goroutines.
+func CrossValidateFunc[X any](data []X, labels []string, cfg CrossValidationConfig, evaluate Evaluate[X]) (*CrossValidationResult, error) {
+	if len(data) == 0 {
+		return nil, errors.New(InvalidDataLearn)
+	}
+
+	cfg, err := setDefault(cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	folds, err := Split(len(data), labels, cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FoldResult, len(folds))
+	trueLabels := make([][]string, len(folds))
+	errs := make([]error, len(folds))
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for worker := 0; worker < cfg.Worker; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for foldIdx := range jobs {
+				fold := folds[foldIdx]
+
+				var train, test []X
+				for _, idx := range fold.Train {
+					train = append(train, data[idx])
+				}
+				for _, idx := range fold.Test {
+					test = append(test, data[idx])
+				}
+
+				foldTrue, foldPredicted, err := evaluate(train, test)
+
+				if err != nil {
+					errs[foldIdx] = err
+					continue
+				}
+
+				report, err := metrics.NewClassificationReport(foldTrue, foldPredicted)
+
+				if err != nil {
+					errs[foldIdx] = err
+					continue
+				}
+
+				trueLabels[foldIdx] = foldTrue
+				results[foldIdx] = FoldResult{
+					Fold:      fold,
+					Predicted: foldPredicted,
+					Report:    report,
+				}
+			}
+		}()
+	}
+
+	for foldIdx := range folds {
+		jobs <- foldIdx
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := CrossValidationResult{
+		Folds: results,
+	}
+
+	var accuracy, macroF1, weightedF1, cohenKappa []float64
+	var allTrue, allPredicted []string
+	for foldIdx, foldResult := range results {
+		accuracy = append(accuracy, foldResult.Report.Accuracy)
+		macroF1 = append(macroF1, foldResult.Report.MacroAverage.F1)
+		weightedF1 = append(weightedF1, foldResult.Report.WeightedAverage.F1)
+		cohenKappa = append(cohenKappa, foldResult.Report.CohenKappa)
+
+		allTrue = append(allTrue, trueLabels[foldIdx]...)
+		allPredicted = append(allPredicted, foldResult.Predicted...)
+	}
+
+	result.Accuracy = getScore(accuracy)
+	result.MacroF1 = getScore(macroF1)
+	result.WeightedF1 = getScore(weightedF1)
+	result.CohenKappa = getScore(cohenKappa)
+
+	result.Report, err = metrics.NewClassificationReport(allTrue, allPredicted)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CrossValidate trains a new classifier from factory on every fold and stratifies on targets.
+func CrossValidate[X any](factory classifier.Factory[X, string], inputs []X, targets []string, cfg CrossValidationConfig) (*CrossValidationResult, error) {
+	if len(inputs) != len(targets) {
+		return nil, errors.New(UnequalLength)
+	}
+
+	type sample struct {
+		input  X
+		target string
+	}
+
+	data := make([]sample, len(inputs))
+	for idx := range inputs {
+		data[idx] = sample{
+			input:  inputs[idx],
+			target: targets[idx],
+		}
+	}
+
+	return CrossValidateFunc(data, targets, cfg, func(train []sample, test []sample) ([]string, []string, error) {
+		model, err := factory()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var trainInputs, testInputs []X
+		var trainTargets, testTargets []string
+		for _, s := range train {
+			trainInputs = append(trainInputs, s.input)
+			trainTargets = append(trainTargets, s.target)
+		}
+		for _, s := range test {
+			testInputs = append(testInputs, s.input)
+			testTargets = append(testTargets, s.target)
+		}
+
+		err = model.Fit(trainInputs, trainTargets)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		predicted, err := model.Predict(testInputs)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return testTargets, predicted, nil
+	})
+}
+
+func getScore(values []float64) Score {
+	var score Score
+	if len(values) == 0 {
+		return score
+	}
+
+	for _, val := range values {
+		score.Mean += val / float64(len(values))
+	}
+
+	for _, val := range values {
+		score.Std += math.Pow(val-score.Mean, 2) / float64(len(values))
+	}
+	score.Std = math.Sqrt(score.Std)
+
+	return score
+}
@@ -0,0 +1,126 @@
+package model_selection
+
+import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"testing"
+)
+
+// majorityClassifier predicts the class seen most during Fit.
+type majorityClassifier struct {
+	class string
+}
+
+func (mc *majorityClassifier) Fit(inputs []string, targets []string) error {
+	counts := make(map[string]int)
+	for _, target := range targets {
+		counts[target]++
+		if counts[target] > counts[mc.class] || (counts[target] == counts[mc.class] && target < mc.class) {
+			mc.class = target
+		}
+	}
+	return nil
+}
+
+func (mc *majorityClassifier) Predict(inputs []string) ([]string, error) {
+	var predicted []string
+	for range inputs {
+		predicted = append(predicted, mc.class)
+	}
+	return predicted, nil
+}
+
+func (mc *majorityClassifier) PredictProbability(inputs []string) ([]map[string]float64, error) {
+	var probabilities []map[string]float64
+	for range inputs {
+		probabilities = append(probabilities, map[string]float64{mc.class: 1})
+	}
+	return probabilities, nil
+}
+
+func TestSplit(t *testing.T) {
+	labels := []string{"a", "a", "a", "a", "a", "a", "b", "b", "b", "c", "c", "c"}
+
+	for _, strategy := range []string{KFold, StratifiedKFold, LeaveOneOut} {
+		folds, err := Split(len(labels), labels, CrossValidationConfig{
+			Strategy: strategy,
+			Fold:     3,
+			Repeat:   2,
+			Seed:     1,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		tested := make(map[int]int)
+		for _, fold := range folds {
+			if len(fold.Train)+len(fold.Test) != len(labels) {
+				t.Errorf("%s Fold Should Cover Every Sample", strategy)
+			}
+
+			for _, idx := range fold.Test {
+				tested[idx]++
+			}
+
+			if strategy == StratifiedKFold {
+				counts := make(map[string]int)
+				for _, idx := range fold.Test {
+					counts[labels[idx]]++
+				}
+
+				if counts["a"] != 2 || counts["b"] != 1 || counts["c"] != 1 {
+					t.Errorf("Stratified Fold Should Keep The Class Ratio, Got %v", counts)
+				}
+			}
+		}
+
+		repeat := 2
+		if strategy == LeaveOneOut {
+			repeat = 1
+		}
+
+		for idx := range labels {
+			if tested[idx] != repeat {
+				t.Errorf("%s Should Test Sample %d Once Every Repeat", strategy, idx)
+			}
+		}
+	}
+
+	_, err := Split(3, nil, CrossValidationConfig{
+		Strategy: KFold,
+		Fold:     4,
+	})
+
+	if err == nil || err.Error() != InvalidFold {
+		t.Errorf("More Fold Than Sample Should Return %s", InvalidFold)
+	}
+}
+
+func TestCrossValidate(t *testing.T) {
+	inputs := []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+	targets := []string{"a", "a", "a", "a", "a", "a", "b", "b"}
+
+	result, err := CrossValidate(func() (classifier.Classifier[string, string], error) {
+		return &majorityClassifier{}, nil
+	}, inputs, targets, CrossValidationConfig{
+		Fold:   2,
+		Repeat: 3,
+		Worker: 4,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(result.Folds) != 6 {
+		t.Errorf("Cross Validation Should Run 6 Folds, Got %d", len(result.Folds))
+	}
+
+	if result.Accuracy.Mean != 0.75 || result.Accuracy.Std != 0 {
+		t.Errorf("Majority Class Should Score 0.75 On Every Fold, Got %+v", result.Accuracy)
+	}
+
+	if result.Report.Classes["b"].Support != 6 || result.Report.Classes["b"].Recall != 0 {
+		t.Errorf("Report Should Pool The Predictions Of Every Fold")
+	}
+}
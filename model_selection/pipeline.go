@@ -0,0 +1,147 @@
+package model_selection
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/term_frequency"
+	"github.com/adrian3ka/go-learn-ai/tf_idf"
+	"github.com/adrian3ka/go-learn-ai/word_vectorizer"
+)
+
+const (
+	ClassifierNil  = "Classifier is Nil"
+	ModelNotFitted = "Model Not Fitted"
+)
+
+// TextClassifierFactory creates the classifier of the pipeline on top of its fitted TF-IDF.
+type TextClassifierFactory func(evaluator tf_idf.TermFrequencyInverseDocumentFrequency) (classifier.Classifier[string, string], error)
+
+type TextPipelineParameter struct {
+	Lower          bool
+	NGram          uint64
+	Binary         bool
+	Smooth         bool
+	NormalizerType string
+}
+
+type TextPipelineConfig struct {
+	Parameter  TextPipelineParameter
+	Classifier TextClassifierFactory
+}
+
+// TextPipeline learns the word vectorizer, the term frequency and the TF-IDF from the documents
+// given to Fit before fitting the classifier, so a cross validation fold never sees the words of
+// its test documents.
+type TextPipeline struct {
+	parameter  TextPipelineParameter
+	factory    TextClassifierFactory
+	evaluator  *tf_idf.TermFrequencyInverseDocumentFrequency
+	classifier classifier.Classifier[string, string]
+}
+
+func NewTextPipeline(cfg TextPipelineConfig) (*TextPipeline, error) {
+	if cfg.Classifier == nil {
+		return nil, errors.New(ClassifierNil)
+	}
+
+	return &TextPipeline{
+		parameter: cfg.Parameter,
+		factory:   cfg.Classifier,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*TextPipeline)(nil)
+
+func (p *TextPipeline) Fit(documents []string, classes []string) error {
+	if len(documents) == 0 || len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	corpuses := make(map[string][]string)
+	for idx, document := range documents {
+		corpuses[classes[idx]] = append(corpuses[classes[idx]], document)
+	}
+
+	wordVectorizer := word_vectorizer.New(word_vectorizer.WordVectorizerConfig{
+		Lower: p.parameter.Lower,
+		NGram: p.parameter.NGram,
+	})
+
+	err := wordVectorizer.Learn(corpuses)
+
+	if err != nil {
+		return err
+	}
+
+	termFrequency := term_frequency.New(term_frequency.TermFrequencyConfig{
+		Binary:         p.parameter.Binary,
+		WordVectorizer: wordVectorizer,
+	})
+
+	err = termFrequency.Learn(wordVectorizer.GetCleanedCorpus())
+
+	if err != nil {
+		return err
+	}
+
+	tfIdf, err := tf_idf.New(tf_idf.TermFrequencyInverseDocumentFrequencyConfig{
+		Smooth:          p.parameter.Smooth,
+		NormalizerType:  p.parameter.NormalizerType,
+		CountVectorizer: termFrequency,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = tfIdf.Fit()
+
+	if err != nil {
+		return err
+	}
+
+	model, err := p.factory(tfIdf)
+
+	if err != nil {
+		return err
+	}
+
+	err = model.Fit(documents, classes)
+
+	if err != nil {
+		return err
+	}
+
+	p.evaluator = &tfIdf
+	p.classifier = model
+
+	return nil
+}
+
+func (p *TextPipeline) Predict(documents []string) ([]string, error) {
+	if p.classifier == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	return p.classifier.Predict(documents)
+}
+
+func (p *TextPipeline) PredictProbability(documents []string) ([]map[string]float64, error) {
+	if p.classifier == nil {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	return p.classifier.PredictProbability(documents)
+}
+
+func (p *TextPipeline) GetParameter() TextPipelineParameter {
+	return p.parameter
+}
+
+func (p *TextPipeline) GetEvaluator() *tf_idf.TermFrequencyInverseDocumentFrequency {
+	return p.evaluator
+}
+
+func (p *TextPipeline) GetClassifier() classifier.Classifier[string, string] {
+	return p.classifier
+}
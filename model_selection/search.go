@@ -0,0 +1,233 @@
+package model_selection
+
+import (
+	"errors"
+	"fmt"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+const (
+	UnknownSearch  = "Unknown Search"
+	UnknownScoring = "Unknown Scoring"
+
+	GridSearch   = "GridSearch"
+	RandomSearch = "RandomSearch"
+
+	AccuracyScoring   = "Accuracy"
+	MacroF1Scoring    = "MacroF1"
+	WeightedF1Scoring = "WeightedF1"
+	CohenKappaScoring = "CohenKappa"
+
+	DefaultSearchIteration = 10
+)
+
+// ParameterGrid lists the values tried for every parameter, an empty list only tries the zero
+// value of the parameter.
+type ParameterGrid struct {
+	Lower          []bool
+	NGram          []uint64
+	Binary         []bool
+	Smooth         []bool
+	NormalizerType []string
+}
+
+// SearchConfig tries every candidate of the grid with GridSearch, or Iteration candidates drawn
+// from the grid with RandomSearch.
+type SearchConfig struct {
+	Grid            ParameterGrid
+	Classifier      TextClassifierFactory
+	Search          string
+	Iteration       int
+	Scoring         string
+	CrossValidation CrossValidationConfig
+	Seed            int64
+}
+
+type SearchCandidate struct {
+	Rank            int
+	Parameter       TextPipelineParameter
+	Score           Score
+	CrossValidation *CrossValidationResult
+}
+
+type SearchResult struct {
+	Scoring    string
+	Candidates []SearchCandidate
+	Best       *TextPipeline
+}
+
+// GetCandidates returns every combination of the grid, the last parameter changes the fastest.
+func (g ParameterGrid) GetCandidates() []TextPipelineParameter {
+	lowers := g.Lower
+	if len(lowers) == 0 {
+		lowers = []bool{false}
+	}
+
+	nGrams := g.NGram
+	if len(nGrams) == 0 {
+		nGrams = []uint64{0}
+	}
+
+	binaries := g.Binary
+	if len(binaries) == 0 {
+		binaries = []bool{false}
+	}
+
+	smooths := g.Smooth
+	if len(smooths) == 0 {
+		smooths = []bool{false}
+	}
+
+	normalizerTypes := g.NormalizerType
+	if len(normalizerTypes) == 0 {
+		normalizerTypes = []string{""}
+	}
+
+	var candidates []TextPipelineParameter
+	for _, lower := range lowers {
+		for _, nGram := range nGrams {
+			for _, binary := range binaries {
+				for _, smooth := range smooths {
+					for _, normalizerType := range normalizerTypes {
+						candidates = append(candidates, TextPipelineParameter{
+							Lower:          lower,
+							NGram:          nGram,
+							Binary:         binary,
+							Smooth:         smooth,
+							NormalizerType: normalizerType,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return candidates
+}
+
+func getCrossValidationScore(result *CrossValidationResult, scoring string) Score {
+	switch scoring {
+	case AccuracyScoring:
+		return result.Accuracy
+	case WeightedF1Scoring:
+		return result.WeightedF1
+	case CohenKappaScoring:
+		return result.CohenKappa
+	}
+
+	return result.MacroF1
+}
+
+// Search cross validates every candidate on the documents, ranks them by the mean score and
+// refits the best candidate on every document.
+func Search(documents []string, classes []string, cfg SearchConfig) (*SearchResult, error) {
+	if cfg.Classifier == nil {
+		return nil, errors.New(ClassifierNil)
+	}
+
+	if cfg.Search == "" {
+		cfg.Search = GridSearch
+	}
+
+	if cfg.Search != GridSearch && cfg.Search != RandomSearch {
+		return nil, errors.New(UnknownSearch)
+	}
+
+	if cfg.Scoring == "" {
+		cfg.Scoring = MacroF1Scoring
+	}
+
+	if cfg.Scoring != AccuracyScoring && cfg.Scoring != MacroF1Scoring &&
+		cfg.Scoring != WeightedF1Scoring && cfg.Scoring != CohenKappaScoring {
+		return nil, errors.New(UnknownScoring)
+	}
+
+	if cfg.Iteration <= 0 {
+		cfg.Iteration = DefaultSearchIteration
+	}
+
+	parameters := cfg.Grid.GetCandidates()
+	if cfg.Search == RandomSearch && cfg.Iteration < len(parameters) {
+		random := rand.New(rand.NewSource(cfg.Seed))
+
+		var sampled []TextPipelineParameter
+		for _, idx := range random.Perm(len(parameters))[0:cfg.Iteration] {
+			sampled = append(sampled, parameters[idx])
+		}
+		parameters = sampled
+	}
+
+	result := SearchResult{
+		Scoring: cfg.Scoring,
+	}
+
+	for _, parameter := range parameters {
+		pipelineConfig := TextPipelineConfig{
+			Parameter:  parameter,
+			Classifier: cfg.Classifier,
+		}
+
+		crossValidation, err := CrossValidate(func() (classifier.Classifier[string, string], error) {
+			return NewTextPipeline(pipelineConfig)
+		}, documents, classes, cfg.CrossValidation)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result.Candidates = append(result.Candidates, SearchCandidate{
+			Parameter:       parameter,
+			Score:           getCrossValidationScore(crossValidation, cfg.Scoring),
+			CrossValidation: crossValidation,
+		})
+	}
+
+	// A lower deviation wins a tie, then the order of the grid.
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		if result.Candidates[i].Score.Mean != result.Candidates[j].Score.Mean {
+			return result.Candidates[i].Score.Mean > result.Candidates[j].Score.Mean
+		}
+		return result.Candidates[i].Score.Std < result.Candidates[j].Score.Std
+	})
+
+	for idx := range result.Candidates {
+		result.Candidates[idx].Rank = idx + 1
+	}
+
+	best, err := NewTextPipeline(TextPipelineConfig{
+		Parameter:  result.Candidates[0].Parameter,
+		Classifier: cfg.Classifier,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = best.Fit(documents, classes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.Best = best
+
+	return &result, nil
+}
+
+func (r *SearchResult) String() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%4s %6s %5s %6s %6s %-18s %10s %10s\n",
+		"rank", "lower", "ngram", "binary", "smooth", "normalizer", r.Scoring, "std"))
+
+	for _, candidate := range r.Candidates {
+		parameter := candidate.Parameter
+		builder.WriteString(fmt.Sprintf("%4d %6t %5d %6t %6t %-18s %10.4f %10.4f\n",
+			candidate.Rank, parameter.Lower, parameter.NGram, parameter.Binary, parameter.Smooth,
+			parameter.NormalizerType, candidate.Score.Mean, candidate.Score.Std))
+	}
+
+	return builder.String()
+}
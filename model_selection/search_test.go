@@ -0,0 +1,88 @@
+package model_selection
+
+import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/naive_bayes"
+	"github.com/adrian3ka/go-learn-ai/tf_idf"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	documents := []string{
+		"Saya mau beli pulsa dong",
+		"jual pulsa gak ya?",
+		"kamu jual voucher ga?",
+		"mau isi pulsa bisa ga ya?",
+		"kamu jual tiket pesawat ga?",
+		"disini jual tiket ga ya?",
+		"bisa beli tiket kereta?",
+		"jual tiket apa ya?",
+		"halo aku mau isi saldo dong",
+		"eh mau topup dong bisa ga?",
+		"mau nambah saldo dong bisa gak",
+		"tolong bantu isi saldo dong 50 ribu",
+	}
+	classes := []string{
+		"pulsa", "pulsa", "pulsa", "pulsa", "tiket", "tiket", "tiket", "tiket", "saldo", "saldo", "saldo", "saldo",
+	}
+
+	factory := func(evaluator tf_idf.TermFrequencyInverseDocumentFrequency) (classifier.Classifier[string, string], error) {
+		nb := naive_bayes.NewMultinomialNaiveBayes(naive_bayes.MultinomialNaiveBayesConfig{
+			Evaluator: evaluator,
+		})
+		return &nb, nil
+	}
+
+	grid := ParameterGrid{
+		Lower:          []bool{false, true},
+		NGram:          []uint64{1, 2},
+		Smooth:         []bool{true},
+		NormalizerType: []string{tf_idf.EuclideanSumSquare, tf_idf.EuclideanSum},
+	}
+
+	for _, search := range []string{GridSearch, RandomSearch} {
+		result, err := Search(documents, classes, SearchConfig{
+			Grid:       grid,
+			Classifier: factory,
+			Search:     search,
+			Iteration:  3,
+			CrossValidation: CrossValidationConfig{
+				Fold: 4,
+				Seed: 1,
+			},
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		expectedLength := 8
+		if search == RandomSearch {
+			expectedLength = 3
+		}
+
+		if len(result.Candidates) != expectedLength {
+			t.Errorf("%s Should Try %d Candidates, Got %d", search, expectedLength, len(result.Candidates))
+		}
+
+		for idx := 1; idx < len(result.Candidates); idx++ {
+			if result.Candidates[idx].Score.Mean > result.Candidates[idx-1].Score.Mean {
+				t.Errorf("%s Candidates Should Be Ranked By Their Score", search)
+			}
+		}
+
+		if result.Best.GetParameter() != result.Candidates[0].Parameter {
+			t.Errorf("%s Should Refit The Best Candidate", search)
+		}
+
+		predicted, err := result.Best.Predict([]string{"mau beli pulsa"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		if len(predicted) != 1 {
+			t.Errorf("%s Best Model Should Predict", search)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package model_selection
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	InvalidTestFraction = "Invalid Test Fraction"
+
+	DefaultTestFraction = 0.1
+)
+
+// SplitConfig stratifies on the labels given to the split when Stratify is set, so every class
+// keeps its share of TestFraction in the test data.
+type SplitConfig struct {
+	TestFraction float64
+	Stratify     bool
+	Seed         int64
+}
+
+// Shuffle returns a shuffled copy of the data, the same seed always gives the same order.
+func Shuffle[X any](data []X, seed int64) []X {
+	random := rand.New(rand.NewSource(seed))
+
+	shuffled := make([]X, len(data))
+	for position, idx := range random.Perm(len(data)) {
+		shuffled[position] = data[idx]
+	}
+
+	return shuffled
+}
+
+// TrainTestSplit keeps the samples sharing a group on the same side, groups can be nil when every
+// sample stands alone. A stratified group is counted under its most common label.
+func TrainTestSplit[X any](data []X, labels []string, groups []string, cfg SplitConfig) ([]X, []X, error) {
+	if cfg.TestFraction == 0 {
+		cfg.TestFraction = DefaultTestFraction
+	}
+
+	if cfg.TestFraction <= 0 || cfg.TestFraction >= 1 {
+		return nil, nil, errors.New(InvalidTestFraction)
+	}
+
+	if (cfg.Stratify && len(labels) != len(data)) || (groups != nil && len(groups) != len(data)) {
+		return nil, nil, errors.New(UnequalLength)
+	}
+
+	var members [][]int
+	if groups == nil {
+		for idx := range data {
+			members = append(members, []int{idx})
+		}
+	} else {
+		groupIndex := make(map[string]int)
+		for idx, group := range groups {
+			if _, exists := groupIndex[group]; !exists {
+				groupIndex[group] = len(members)
+				members = append(members, nil)
+			}
+			members[groupIndex[group]] = append(members[groupIndex[group]], idx)
+		}
+	}
+
+	buckets := make(map[string][]int)
+	for groupIdx, groupMembers := range members {
+		label := ""
+		if cfg.Stratify {
+			label = getMajorityLabel(labels, groupMembers)
+		}
+		buckets[label] = append(buckets[label], groupIdx)
+	}
+
+	var bucketLabels []string
+	for label := range buckets {
+		bucketLabels = append(bucketLabels, label)
+	}
+	sort.Strings(bucketLabels)
+
+	random := rand.New(rand.NewSource(cfg.Seed))
+
+	var train, test []X
+	for _, label := range bucketLabels {
+		bucket := buckets[label]
+
+		total := 0
+		for _, groupIdx := range bucket {
+			total += len(members[groupIdx])
+		}
+
+		testLength := int(math.Round(cfg.TestFraction * float64(total)))
+
+		testCount := 0
+		for _, bucketIdx := range random.Perm(len(bucket)) {
+			groupMembers := members[bucket[bucketIdx]]
+			for _, idx := range groupMembers {
+				if testCount < testLength {
+					test = append(test, data[idx])
+				} else {
+					train = append(train, data[idx])
+				}
+			}
+
+			if testCount < testLength {
+				testCount += len(groupMembers)
+			}
+		}
+	}
+
+	return Shuffle(train, cfg.Seed), Shuffle(test, cfg.Seed), nil
+}
+
+func getMajorityLabel(labels []string, members []int) string {
+	counts := make(map[string]int)
+	majority := labels[members[0]]
+	for _, idx := range members {
+		label := labels[idx]
+		counts[label]++
+		if counts[label] > counts[majority] || (counts[label] == counts[majority] && label < majority) {
+			majority = label
+		}
+	}
+
+	return majority
+}
+
+// SplitCorpus splits every class of a labelled corpus, groups has the same shape as the corpus
+// and holds the group of every document, like the conversation it was taken from.
+func SplitCorpus(corpus map[string][]string, groups map[string][]string, cfg SplitConfig) (map[string][]string, map[string][]string, error) {
+	type document struct {
+		class string
+		text  string
+	}
+
+	var classes []string
+	for corpusClass := range corpus {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	var documents []document
+	var labels, documentGroups []string
+	for _, corpusClass := range classes {
+		if groups != nil && len(groups[corpusClass]) != len(corpus[corpusClass]) {
+			return nil, nil, errors.New(UnequalLength)
+		}
+
+		for idx, text := range corpus[corpusClass] {
+			documents = append(documents, document{
+				class: corpusClass,
+				text:  text,
+			})
+			labels = append(labels, corpusClass)
+
+			if groups != nil {
+				documentGroups = append(documentGroups, groups[corpusClass][idx])
+			}
+		}
+	}
+
+	trainDocuments, testDocuments, err := TrainTestSplit(documents, labels, documentGroups, cfg)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	train := make(map[string][]string)
+	for _, d := range trainDocuments {
+		train[d.class] = append(train[d.class], d.text)
+	}
+
+	test := make(map[string][]string)
+	for _, d := range testDocuments {
+		test[d.class] = append(test[d.class], d.text)
+	}
+
+	return train, test, nil
+}
+
+// SplitTaggedSentence splits sentences in the format of tagger.StringToTuple, groups holds the
+// group of every sentence and can be nil. Sentences have no single label to stratify on.
+func SplitTaggedSentence(sentences [][][2]string, groups []string, cfg SplitConfig) ([][][2]string, [][][2]string, error) {
+	return TrainTestSplit(sentences, nil, groups, cfg)
+}
@@ -0,0 +1,81 @@
+package model_selection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCorpus(t *testing.T) {
+	corpus := map[string][]string{
+		"pulsa": {"p1", "p2", "p3", "p4", "p5", "p6", "p7", "p8", "p9", "p10"},
+		"tiket": {"t1", "t2", "t3", "t4", "t5"},
+	}
+
+	train, test, err := SplitCorpus(corpus, nil, SplitConfig{
+		TestFraction: 0.2,
+		Stratify:     true,
+		Seed:         1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(test["pulsa"]) != 2 || len(test["tiket"]) != 1 || len(train["pulsa"]) != 8 || len(train["tiket"]) != 4 {
+		t.Errorf("Stratified Split Should Keep The Class Ratio, Got %v And %v", train, test)
+	}
+
+	sameTrain, sameTest, err := SplitCorpus(corpus, nil, SplitConfig{
+		TestFraction: 0.2,
+		Stratify:     true,
+		Seed:         1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !reflect.DeepEqual(train, sameTrain) || !reflect.DeepEqual(test, sameTest) {
+		t.Errorf("Split With The Same Seed Should Be Reproducible")
+	}
+}
+
+func TestSplitTaggedSentence(t *testing.T) {
+	var sentences [][][2]string
+	var groups []string
+	for idx := 0; idx < 20; idx++ {
+		conversation := string(rune('a' + idx/4))
+		sentences = append(sentences, [][2]string{{conversation, "NN"}})
+		groups = append(groups, conversation)
+	}
+
+	train, test, err := SplitTaggedSentence(sentences, groups, SplitConfig{
+		TestFraction: 0.2,
+		Seed:         3,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(train)+len(test) != len(sentences) || len(test) != 4 {
+		t.Errorf("Split Should Hold 4 Test Sentences, Got %d", len(test))
+	}
+
+	trainConversation := make(map[string]bool)
+	for _, sentence := range train {
+		trainConversation[sentence[0][0]] = true
+	}
+
+	for _, sentence := range test {
+		if trainConversation[sentence[0][0]] {
+			t.Errorf("Conversation %s Should Not Be In Both Sets", sentence[0][0])
+		}
+	}
+
+	_, _, err = SplitTaggedSentence(sentences, groups[1:], SplitConfig{})
+
+	if err == nil || err.Error() != UnequalLength {
+		t.Errorf("Groups Of Another Length Should Return %s", UnequalLength)
+	}
+}
@@ -0,0 +1,164 @@
+package naive_bayes
+
+import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math"
+	"sort"
+)
+
+type ComplementNaiveBayesConfig struct {
+	Evaluator           EvaluatorInterface
+	WeightNormalization bool
+}
+
+type ComplementNaiveBayes struct {
+	evaluator           EvaluatorInterface
+	weightNormalization bool
+}
+
+func NewComplementNaiveBayes(cfg ComplementNaiveBayesConfig) ComplementNaiveBayes {
+	complementNaiveBayes := ComplementNaiveBayes{
+		evaluator:           cfg.Evaluator,
+		weightNormalization: cfg.WeightNormalization,
+	}
+
+	return complementNaiveBayes
+}
+
+var _ classifier.Classifier[string, string] = (*ComplementNaiveBayes)(nil)
+
+func (nb *ComplementNaiveBayes) Fit(documents []string, classes []string) error {
+	evaluator, err := newFittedEvaluator(nb.evaluator, documents, classes)
+
+	if err != nil {
+		return err
+	}
+
+	nb.evaluator = evaluator
+
+	return nil
+}
+
+func (nb ComplementNaiveBayes) getClasses() []string {
+	var classes []string
+	for corpusClass := range nb.evaluator.GetTrainedData() {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	return classes
+}
+
+// getWeights estimates the weight of every word for each class from the documents
+// that are NOT in the class, so small classes get as much evidence as the big ones.
+func (nb ComplementNaiveBayes) getWeights() map[string][]float64 {
+	dictionaryLength := len(nb.evaluator.GetDictionary())
+	classes := nb.getClasses()
+
+	allVectorData := make([]float64, dictionaryLength)
+	for _, corpusClass := range classes {
+		for idx, val := range nb.evaluator.GetSumVectorDataOfClass(corpusClass) {
+			allVectorData[idx] += val
+		}
+	}
+
+	weights := make(map[string][]float64)
+	for _, corpusClass := range classes {
+		classVectorData := nb.evaluator.GetSumVectorDataOfClass(corpusClass)
+
+		complementVectorData := make([]float64, dictionaryLength)
+		totalComplement := float64(0)
+		for idx := range complementVectorData {
+			complementVectorData[idx] = allVectorData[idx] + CONSTANT
+			if idx < len(classVectorData) {
+				complementVectorData[idx] -= classVectorData[idx]
+			}
+			totalComplement += complementVectorData[idx]
+		}
+
+		weight := make([]float64, dictionaryLength)
+		totalWeight := float64(0)
+		for idx, val := range complementVectorData {
+			weight[idx] = math.Log(val / totalComplement)
+			totalWeight += weight[idx]
+		}
+
+		for idx := range weight {
+			if nb.weightNormalization {
+				weight[idx] = weight[idx] / totalWeight
+			} else {
+				weight[idx] = -weight[idx]
+			}
+		}
+
+		weights[corpusClass] = weight
+	}
+
+	return weights
+}
+
+func (nb ComplementNaiveBayes) Predict(inputs []string) ([]string, error) {
+	var predicted []string
+	probabilities, err := nb.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	classes := nb.getClasses()
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (nb ComplementNaiveBayes) PredictProbability(inputs []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := nb.evaluator.EvaluateInput(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	weights := nb.getWeights()
+
+	var allPrediction []map[string]float64
+
+	for _, evaluatedInput := range evaluatedInputs {
+		var scores = make(map[string]float64)
+		highestScore := math.Inf(-1)
+		for corpusClass, weight := range weights {
+			score := float64(0)
+			for idx, val := range evaluatedInput {
+				score += val * weight[idx]
+			}
+
+			scores[corpusClass] = score
+			if highestScore < score {
+				highestScore = score
+			}
+		}
+
+		denominator := float64(0)
+		for corpusClass, score := range scores {
+			scores[corpusClass] = math.Exp(score - highestScore)
+			denominator += scores[corpusClass]
+		}
+
+		for corpusClass, score := range scores {
+			scores[corpusClass] = score / denominator
+		}
+
+		allPrediction = append(allPrediction, scores)
+	}
+
+	return allPrediction, nil
+}
@@ -0,0 +1,126 @@
+package naive_bayes
+
+import (
+	"math"
+	"testing"
+)
+
+var (
+	ImbalancedDocuments = []string{
+		"beli pulsa murah", "isi pulsa murah sekali", "pulsa murah promo", "paket data murah",
+		"beli paket data", "isi pulsa sekarang", "promo pulsa murah", "paket internet murah",
+		"tiket kereta", "pesan tiket", "token listrik", "bayar token listrik",
+	}
+	ImbalancedClasses = []string{
+		"pulsa", "pulsa", "pulsa", "pulsa", "pulsa", "pulsa", "pulsa", "pulsa",
+		"tiket", "tiket", "listrik", "listrik",
+	}
+	ImbalancedTest = map[string]string{
+		"isi token pulsa":     "pulsa",
+		"paket data bayar":    "pulsa",
+		"isi pulsa murah":     "pulsa",
+		"pesan tiket murah":   "tiket",
+		"bayar token listrik": "listrik",
+	}
+)
+
+func TestComplementNaiveBayes(t *testing.T) {
+	var inputs, expected []string
+	for input, corpusClass := range ImbalancedTest {
+		inputs = append(inputs, input)
+		expected = append(expected, corpusClass)
+	}
+
+	multinomial := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(ImbalancedDocuments),
+	})
+
+	err := multinomial.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	complement := NewComplementNaiveBayes(ComplementNaiveBayesConfig{
+		Evaluator: newCountEvaluator(ImbalancedDocuments),
+	})
+
+	err = complement.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	multinomialPredicted, err := multinomial.Predict(inputs)
+
+	if err != nil {
+		panic(err)
+	}
+
+	complementPredicted, err := complement.Predict(inputs)
+
+	if err != nil {
+		panic(err)
+	}
+
+	multinomialCorrect, complementCorrect := 0, 0
+	for idx := range expected {
+		if multinomialPredicted[idx] == expected[idx] {
+			multinomialCorrect++
+		}
+
+		if complementPredicted[idx] == expected[idx] {
+			complementCorrect++
+		} else {
+			t.Errorf("Complement Naive Bayes Should Predict %s As %s, Got %s", inputs[idx], expected[idx], complementPredicted[idx])
+		}
+	}
+
+	if complementCorrect <= multinomialCorrect {
+		t.Errorf("Complement Naive Bayes Should Beat Multinomial Naive Bayes On Imbalanced Classes, Got %d Against %d",
+			complementCorrect, multinomialCorrect)
+	}
+}
+
+func TestComplementNaiveBayesProbability(t *testing.T) {
+	for _, weightNormalization := range []bool{false, true} {
+		nb := NewComplementNaiveBayes(ComplementNaiveBayesConfig{
+			Evaluator:           newCountEvaluator(ImbalancedDocuments),
+			WeightNormalization: weightNormalization,
+		})
+
+		err := nb.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+		if err != nil {
+			panic(err)
+		}
+
+		probabilities, err := nb.PredictProbability([]string{"isi pulsa murah", "bayar token listrik", "halo"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		for _, prob := range probabilities {
+			if len(prob) != 3 {
+				t.Errorf("Probability Should Cover All 3 Classes, Got %v", prob)
+			}
+
+			total := float64(0)
+			for corpusClass, val := range prob {
+				if val < 0 || val > 1 {
+					t.Errorf("Probability Of %s Should Be Between 0 And 1, Got %f", corpusClass, val)
+				}
+				total += val
+			}
+
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("Probability With Weight Normalization %t Should Sum To 1, Got %f", weightNormalization, total)
+			}
+		}
+
+		if probabilities[2]["pulsa"] != probabilities[2]["tiket"] || probabilities[2]["tiket"] != probabilities[2]["listrik"] {
+			t.Errorf("Unknown Words Should Give Every Class The Same Probability, Got %v", probabilities[2])
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package naive_bayes
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	UnknownClass = "Unknown Class"
+)
+
+// WordContribution holds Contribution, the value times the log probability of the word in the
+// explained class, and Contrast, the value times how much more likely the word is in the explained
+// class than in the class it is compared against. A positive Contrast pushed the input toward the
+// explained class.
+type WordContribution struct {
+	Word                  string
+	Value                 float64
+	LogProbability        float64
+	AgainstLogProbability float64
+	Contribution          float64
+	Contrast              float64
+}
+
+// Explanation compares Class against the class Against. MultinomialNaiveBayes has no class prior,
+// so Prior is the uniform -log(number of classes) and LogOdds, the log of the probability of Class
+// over the probability of Against, is the sum of the Contrast of every word.
+type Explanation struct {
+	Class          string
+	Against        string
+	Prior          float64
+	Words          []WordContribution
+	LogProbability float64
+	LogOdds        float64
+	Probability    float64
+}
+
+func (nb MultinomialNaiveBayes) getLogProbabilityOfClass(corpusClass string) []float64 {
+	return getLogProbability(
+		nb.evaluator.GetSumVectorDataOfClass(corpusClass),
+		nb.evaluator.GetSumDataOfClass(corpusClass),
+		float64(len(nb.evaluator.GetDictionary())),
+	)
+}
+
+// Explain breaks down the score of the class for the input into the contribution of each word,
+// against the predicted class, or against the runner up when the class is the predicted one.
+func (nb MultinomialNaiveBayes) Explain(input string, class string) (*Explanation, error) {
+	probabilities, err := nb.PredictProbability([]string{input})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The first ranked class that is not the explained one is the predicted class or the runner up.
+	against := class
+	for _, rankedClass := range rankClasses(probabilities[0]) {
+		if rankedClass.Key != class {
+			against = rankedClass.Key
+			break
+		}
+	}
+
+	return nb.ExplainAgainst(input, class, against)
+}
+
+// ExplainAgainst breaks down why the input scores the class over the given class, the words are
+// sorted from the one that pushed the most toward class to the one that pushed the most toward against.
+func (nb MultinomialNaiveBayes) ExplainAgainst(input string, class string, against string) (*Explanation, error) {
+	if _, exists := nb.evaluator.GetTrainedData()[class]; !exists {
+		return nil, errors.New(UnknownClass)
+	}
+
+	if _, exists := nb.evaluator.GetTrainedData()[against]; !exists {
+		return nil, errors.New(UnknownClass)
+	}
+
+	evaluatedInputs, err := nb.evaluator.EvaluateInput([]string{input})
+
+	if err != nil {
+		return nil, err
+	}
+
+	probabilities, err := nb.PredictProbability([]string{input})
+
+	if err != nil {
+		return nil, err
+	}
+
+	words := make(map[uint64]string)
+	for word, idx := range nb.evaluator.GetDictionary() {
+		words[idx] = word
+	}
+
+	explanation := Explanation{
+		Class:       class,
+		Against:     against,
+		Prior:       -math.Log(float64(len(nb.evaluator.GetTrainedData()))),
+		Probability: probabilities[0][class],
+	}
+	explanation.LogProbability = explanation.Prior
+
+	againstLogProbability := nb.getLogProbabilityOfClass(against)
+	for idx, logProbability := range nb.getLogProbabilityOfClass(class) {
+		value := evaluatedInputs[0][idx]
+		if value == 0 {
+			continue
+		}
+
+		wordContribution := WordContribution{
+			Word:                  words[uint64(idx)],
+			Value:                 value,
+			LogProbability:        logProbability,
+			AgainstLogProbability: againstLogProbability[idx],
+			Contribution:          value * logProbability,
+			Contrast:              value * (logProbability - againstLogProbability[idx]),
+		}
+
+		explanation.Words = append(explanation.Words, wordContribution)
+		explanation.LogProbability += wordContribution.Contribution
+		explanation.LogOdds += wordContribution.Contrast
+	}
+
+	sort.Slice(explanation.Words, func(i, j int) bool {
+		if explanation.Words[i].Contrast == explanation.Words[j].Contrast {
+			return explanation.Words[i].Word < explanation.Words[j].Word
+		}
+		return explanation.Words[i].Contrast > explanation.Words[j].Contrast
+	})
+
+	return &explanation, nil
+}
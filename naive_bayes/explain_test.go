@@ -0,0 +1,155 @@
+package naive_bayes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(ImbalancedDocuments),
+	})
+
+	err := nb.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	input := "isi pulsa pulsa token halo"
+	probabilities, err := nb.PredictProbability([]string{input})
+
+	if err != nil {
+		panic(err)
+	}
+
+	explanations := make(map[string]*Explanation)
+	for _, corpusClass := range []string{"pulsa", "tiket", "listrik"} {
+		explanation, err := nb.Explain(input, corpusClass)
+
+		if err != nil {
+			panic(err)
+		}
+
+		sum := explanation.Prior
+		for _, word := range explanation.Words {
+			sum += word.Contribution
+
+			if word.Contribution != word.Value*word.LogProbability {
+				t.Errorf("Contribution Of %s Should Be Its Value Times Its Log Probability", word.Word)
+			}
+		}
+
+		if math.Abs(sum-explanation.LogProbability) > 1e-9 {
+			t.Errorf("Contributions Of %s Should Sum To %f, Got %f", corpusClass, explanation.LogProbability, sum)
+		}
+
+		if len(explanation.Words) != 3 {
+			t.Errorf("Only The 3 Known Words Should Contribute To %s, Got %d", corpusClass, len(explanation.Words))
+		}
+
+		if explanation.Probability != probabilities[0][corpusClass] {
+			t.Errorf("Probability Of %s Should Match PredictProbability", corpusClass)
+		}
+
+		explanations[corpusClass] = explanation
+	}
+
+	for _, corpusClass := range []string{"tiket", "listrik"} {
+		logOdds := explanations["pulsa"].LogProbability - explanations[corpusClass].LogProbability
+		expected := math.Log(probabilities[0]["pulsa"] / probabilities[0][corpusClass])
+
+		if math.Abs(logOdds-expected) > 1e-9 {
+			t.Errorf("Log Odds Of Pulsa Against %s Should Be %f, Got %f", corpusClass, expected, logOdds)
+		}
+	}
+
+	for _, word := range explanations["pulsa"].Words {
+		if word.Word == "pulsa" && word.Value != 2 {
+			t.Errorf("Repeated Word Should Have Value 2, Got %f", word.Value)
+		}
+	}
+
+	_, err = nb.Explain(input, "hotel")
+
+	if err == nil || err.Error() != UnknownClass {
+		t.Errorf("Unknown Class Should Return %s", UnknownClass)
+	}
+}
+
+func TestExplainContrast(t *testing.T) {
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(ImbalancedDocuments),
+	})
+
+	err := nb.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The small listrik class wins this pulsa input, token is the word that pulls it away.
+	input := "isi token pulsa"
+	probabilities, err := nb.PredictProbability([]string{input})
+
+	if err != nil {
+		panic(err)
+	}
+
+	explanation, err := nb.Explain(input, "pulsa")
+
+	if err != nil {
+		panic(err)
+	}
+
+	if explanation.Against != "listrik" {
+		t.Errorf("Pulsa Should Be Explained Against The Predicted Listrik, Got %s", explanation.Against)
+	}
+
+	expected := math.Log(probabilities[0]["pulsa"] / probabilities[0]["listrik"])
+	if math.Abs(explanation.LogOdds-expected) > 1e-9 {
+		t.Errorf("Contrasts Should Sum To The Log Odds %f, Got %f", expected, explanation.LogOdds)
+	}
+
+	for idx := 1; idx < len(explanation.Words); idx++ {
+		if explanation.Words[idx-1].Contrast < explanation.Words[idx].Contrast {
+			t.Errorf("Words Should Be Sorted By Their Contrast, Got %+v", explanation.Words)
+		}
+	}
+
+	last := explanation.Words[len(explanation.Words)-1]
+	if last.Word != "token" || last.Contrast >= 0 {
+		t.Errorf("Token Should Push The Input The Most Toward Listrik, Got %+v", last)
+	}
+
+	if explanation.Words[0].Contrast <= 0 {
+		t.Errorf("Pulsa Words Should Push The Input Toward Pulsa, Got %+v", explanation.Words[0])
+	}
+
+	explanation, err = nb.Explain(input, "listrik")
+
+	if err != nil {
+		panic(err)
+	}
+
+	if explanation.Against != "pulsa" || explanation.LogOdds <= 0 {
+		t.Errorf("Predicted Class Should Be Explained Against The Runner Up, Got %s With %f", explanation.Against, explanation.LogOdds)
+	}
+
+	explanation, err = nb.ExplainAgainst(input, "pulsa", "tiket")
+
+	if err != nil {
+		panic(err)
+	}
+
+	expected = math.Log(probabilities[0]["pulsa"] / probabilities[0]["tiket"])
+	if explanation.Against != "tiket" || math.Abs(explanation.LogOdds-expected) > 1e-9 {
+		t.Errorf("Pulsa Against Tiket Should Have The Log Odds %f, Got %f", expected, explanation.LogOdds)
+	}
+
+	_, err = nb.ExplainAgainst(input, "pulsa", "hotel")
+
+	if err == nil || err.Error() != UnknownClass {
+		t.Errorf("Unknown Class To Compare Against Should Return %s", UnknownClass)
+	}
+}
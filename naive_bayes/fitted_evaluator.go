@@ -0,0 +1,99 @@
+package naive_bayes
+
+import (
+	"errors"
+)
+
+const (
+	EvaluatorNotFitted = "Evaluator Not Fitted"
+)
+
+// fittedEvaluator keeps the vocabulary and weighting of an already trained evaluator,
+// but answers the per class statistics from the documents given to Fit. The evaluator is not
+// refit, so an evaluator without dictionary or one giving only zero vectors is an error.
+type fittedEvaluator struct {
+	EvaluatorInterface
+	data                  map[string][][]float64
+	sumVectorDataPerClass map[string][]float64
+	sumDataPerClass       map[string]float64
+}
+
+func newFittedEvaluator(evaluator EvaluatorInterface, documents []string, classes []string) (*fittedEvaluator, error) {
+	if evaluator == nil || len(documents) == 0 || len(documents) != len(classes) {
+		return nil, errors.New(InvalidDataLearn)
+	}
+
+	if fitted, ok := evaluator.(*fittedEvaluator); ok {
+		evaluator = fitted.EvaluatorInterface
+	}
+
+	dictionaryLength := len(evaluator.GetDictionary())
+	if dictionaryLength == 0 {
+		return nil, errors.New(EvaluatorNotFitted)
+	}
+
+	evaluatedInputs, err := evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	total := float64(0)
+	for _, evaluatedInput := range evaluatedInputs {
+		if len(evaluatedInput) != dictionaryLength {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+
+		for _, val := range evaluatedInput {
+			total += val
+		}
+	}
+
+	if total == 0 {
+		return nil, errors.New(EvaluatorNotFitted)
+	}
+
+	fe := fittedEvaluator{
+		EvaluatorInterface:    evaluator,
+		data:                  make(map[string][][]float64),
+		sumVectorDataPerClass: make(map[string][]float64),
+		sumDataPerClass:       make(map[string]float64),
+	}
+
+	for idx, evaluatedInput := range evaluatedInputs {
+		corpusClass := classes[idx]
+
+		if _, exists := fe.sumVectorDataPerClass[corpusClass]; !exists {
+			fe.sumVectorDataPerClass[corpusClass] = make([]float64, len(evaluatedInput))
+		}
+
+		for wordIndex, val := range evaluatedInput {
+			fe.sumVectorDataPerClass[corpusClass][wordIndex] += val
+			fe.sumDataPerClass[corpusClass] += val
+		}
+
+		fe.data[corpusClass] = append(fe.data[corpusClass], evaluatedInput)
+	}
+
+	return &fe, nil
+}
+
+func (fe *fittedEvaluator) GetTrainedData() map[string][][]float64 {
+	return fe.data
+}
+
+func (fe *fittedEvaluator) GetSumVectorDataOfClass(class string) []float64 {
+	if val, exists := fe.sumVectorDataPerClass[class]; exists {
+		return val
+	}
+
+	return nil
+}
+
+func (fe *fittedEvaluator) GetSumDataOfClass(class string) float64 {
+	if val, exists := fe.sumDataPerClass[class]; exists {
+		return val
+	}
+
+	return 0
+}
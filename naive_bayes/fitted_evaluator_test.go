@@ -0,0 +1,102 @@
+package naive_bayes
+
+import (
+	"github.com/adrian3ka/go-learn-ai/term_frequency"
+	"github.com/adrian3ka/go-learn-ai/tf_idf"
+	"github.com/adrian3ka/go-learn-ai/word_vectorizer"
+	"testing"
+)
+
+func newTfIdf(corpuses map[string][]string, fit bool) tf_idf.TermFrequencyInverseDocumentFrequency {
+	wordVectorizer := word_vectorizer.New(word_vectorizer.WordVectorizerConfig{
+		Lower: true,
+	})
+
+	err := wordVectorizer.Learn(corpuses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	termFrequency := term_frequency.New(term_frequency.TermFrequencyConfig{
+		WordVectorizer: &wordVectorizer,
+	})
+
+	err = termFrequency.Learn(wordVectorizer.GetCleanedCorpus())
+
+	if err != nil {
+		panic(err)
+	}
+
+	tfIdf, err := tf_idf.New(tf_idf.TermFrequencyInverseDocumentFrequencyConfig{
+		CountVectorizer: &termFrequency,
+		Smooth:          true,
+		NormalizerType:  tf_idf.EuclideanSumSquare,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if fit {
+		err = tfIdf.Fit()
+
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return tfIdf
+}
+
+func TestFitUnfittedEvaluator(t *testing.T) {
+	corpuses := make(map[string][]string)
+	for idx, document := range Documents {
+		corpuses[Classes[idx]] = append(corpuses[Classes[idx]], document)
+	}
+
+	for name, tfIdf := range map[string]tf_idf.TermFrequencyInverseDocumentFrequency{
+		"Empty Vocabulary": newTfIdf(map[string][]string{}, false),
+		"Unfitted Tf Idf":  newTfIdf(corpuses, false),
+	} {
+		multinomial := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+			Evaluator: tfIdf,
+		})
+
+		err := multinomial.Fit(Documents, Classes)
+
+		if err == nil || err.Error() != EvaluatorNotFitted {
+			t.Errorf("Multinomial Fit With %s Should Return %s", name, EvaluatorNotFitted)
+		}
+
+		complement := NewComplementNaiveBayes(ComplementNaiveBayesConfig{
+			Evaluator: tfIdf,
+		})
+
+		err = complement.Fit(Documents, Classes)
+
+		if err == nil || err.Error() != EvaluatorNotFitted {
+			t.Errorf("Complement Fit With %s Should Return %s", name, EvaluatorNotFitted)
+		}
+	}
+
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newTfIdf(corpuses, true),
+	})
+
+	err := nb.Fit(Documents, Classes)
+
+	if err != nil {
+		t.Errorf("Fit With A Fitted Tf Idf Should Succeed, Got %s", err.Error())
+	}
+
+	predicted, err := nb.Predict([]string{"jual pulsa ga ya?"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predicted[0] != "pulsa" {
+		t.Errorf("Fitted Model Should Predict Pulsa, Got %s", predicted[0])
+	}
+}
@@ -0,0 +1,205 @@
+package naive_bayes
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math"
+	"sort"
+)
+
+const (
+	InvalidDataLearn      = "Invalid Data Learn"
+	UnequalFeatureLength  = "Unequal Feature Length"
+	ModelNotFitted        = "Model Not Fitted"
+	DefaultVarianceSmooth = 1e-9
+)
+
+type GaussianNaiveBayesConfig struct {
+	VarianceSmoothing float64
+}
+
+type GaussianNaiveBayes struct {
+	varianceSmoothing float64
+	classes           []string
+	prior             map[string]float64
+	mean              map[string][]float64
+	variance          map[string][]float64
+	featureLength     int
+}
+
+func NewGaussianNaiveBayes(cfg GaussianNaiveBayesConfig) *GaussianNaiveBayes {
+	if cfg.VarianceSmoothing == 0 {
+		cfg.VarianceSmoothing = DefaultVarianceSmooth
+	}
+
+	return &GaussianNaiveBayes{
+		varianceSmoothing: cfg.VarianceSmoothing,
+	}
+}
+
+var _ classifier.Classifier[[]float64, string] = (*GaussianNaiveBayes)(nil)
+
+func (nb *GaussianNaiveBayes) Fit(data [][]float64, targetClass []string) error {
+	if len(data) == 0 || len(data) != len(targetClass) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	featureLength := len(data[0])
+	groupedData := make(map[string][][]float64)
+	for idx, row := range data {
+		if len(row) != featureLength {
+			return errors.New(UnequalFeatureLength)
+		}
+		groupedData[targetClass[idx]] = append(groupedData[targetClass[idx]], row)
+	}
+
+	nb.featureLength = featureLength
+	nb.classes = nil
+	nb.prior = make(map[string]float64)
+	nb.mean = make(map[string][]float64)
+	nb.variance = make(map[string][]float64)
+
+	for corpusClass, rows := range groupedData {
+		nb.classes = append(nb.classes, corpusClass)
+		nb.prior[corpusClass] = float64(len(rows)) / float64(len(data))
+
+		mean := make([]float64, featureLength)
+		for _, row := range rows {
+			for idx, val := range row {
+				mean[idx] += val
+			}
+		}
+		for idx := range mean {
+			mean[idx] /= float64(len(rows))
+		}
+
+		variance := make([]float64, featureLength)
+		for _, row := range rows {
+			for idx, val := range row {
+				variance[idx] += math.Pow(val-mean[idx], 2)
+			}
+		}
+		for idx := range variance {
+			variance[idx] /= float64(len(rows))
+		}
+
+		nb.mean[corpusClass] = mean
+		nb.variance[corpusClass] = variance
+	}
+	sort.Strings(nb.classes)
+
+	// Smooth the variance with a portion of the largest feature variance, so a
+	// feature that is constant inside a class does not divide by zero.
+	allMean := make([]float64, featureLength)
+	for _, row := range data {
+		for idx, val := range row {
+			allMean[idx] += val / float64(len(data))
+		}
+	}
+	largestVariance := float64(0)
+	for idx := range allMean {
+		variance := float64(0)
+		for _, row := range data {
+			variance += math.Pow(row[idx]-allMean[idx], 2) / float64(len(data))
+		}
+		largestVariance = math.Max(largestVariance, variance)
+	}
+
+	epsilon := nb.varianceSmoothing * largestVariance
+	if epsilon == 0 {
+		epsilon = nb.varianceSmoothing
+	}
+	for _, variance := range nb.variance {
+		for idx := range variance {
+			variance[idx] += epsilon
+		}
+	}
+
+	return nil
+}
+
+func (nb *GaussianNaiveBayes) Predict(data [][]float64) ([]string, error) {
+	var predicted []string
+	probabilities, err := nb.PredictProbability(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range nb.classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (nb *GaussianNaiveBayes) PredictProbability(data [][]float64) ([]map[string]float64, error) {
+	if len(nb.classes) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	var allPrediction []map[string]float64
+
+	for _, row := range data {
+		if len(row) != nb.featureLength {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+
+		logLikelihood := make(map[string]float64)
+		highestLogLikelihood := math.Inf(-1)
+		for _, corpusClass := range nb.classes {
+			value := math.Log(nb.prior[corpusClass])
+			for idx, val := range row {
+				mean := nb.mean[corpusClass][idx]
+				variance := nb.variance[corpusClass][idx]
+				value -= 0.5*math.Log(2*math.Pi*variance) + math.Pow(val-mean, 2)/(2*variance)
+			}
+
+			logLikelihood[corpusClass] = value
+			highestLogLikelihood = math.Max(highestLogLikelihood, value)
+		}
+
+		denominator := float64(0)
+		predictedClass := make(map[string]float64)
+		for corpusClass, value := range logLikelihood {
+			predictedClass[corpusClass] = math.Exp(value - highestLogLikelihood)
+			denominator += predictedClass[corpusClass]
+		}
+
+		for corpusClass, predictedValue := range predictedClass {
+			predictedClass[corpusClass] = predictedValue / denominator
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (nb *GaussianNaiveBayes) GetClasses() []string {
+	return nb.classes
+}
+
+func (nb *GaussianNaiveBayes) GetMean(class string) []float64 {
+	if val, exists := nb.mean[class]; exists {
+		return val
+	}
+
+	return nil
+}
+
+func (nb *GaussianNaiveBayes) GetVariance(class string) []float64 {
+	if val, exists := nb.variance[class]; exists {
+		return val
+	}
+
+	return nil
+}
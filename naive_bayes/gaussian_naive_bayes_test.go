@@ -0,0 +1,100 @@
+package naive_bayes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGaussianNaiveBayes(t *testing.T) {
+	data := [][]float64{{1, 5}, {3, 5}, {5, 7}, {9, 7}, {7, 7}}
+	targetClass := []string{"a", "a", "b", "b", "b"}
+
+	nb := NewGaussianNaiveBayes(GaussianNaiveBayesConfig{
+		VarianceSmoothing: 0.01,
+	})
+
+	err := nb.Fit(data, targetClass)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The largest variance over all rows is 8 on the first feature, so every variance is
+	// smoothed by 0.01 * 8 and the second feature, constant inside each class, stays above zero.
+	expectedMean := map[string][]float64{"a": {2, 5}, "b": {7, 7}}
+	expectedVariance := map[string][]float64{"a": {1.08, 0.08}, "b": {8.0/3 + 0.08, 0.08}}
+	for _, corpusClass := range nb.GetClasses() {
+		for idx := range expectedMean[corpusClass] {
+			if math.Abs(nb.GetMean(corpusClass)[idx]-expectedMean[corpusClass][idx]) > 1e-9 {
+				t.Errorf("Mean Of Feature %d In Class %s Should Be %f, Got %f",
+					idx, corpusClass, expectedMean[corpusClass][idx], nb.GetMean(corpusClass)[idx])
+			}
+
+			if math.Abs(nb.GetVariance(corpusClass)[idx]-expectedVariance[corpusClass][idx]) > 1e-9 {
+				t.Errorf("Variance Of Feature %d In Class %s Should Be %f, Got %f",
+					idx, corpusClass, expectedVariance[corpusClass][idx], nb.GetVariance(corpusClass)[idx])
+			}
+		}
+	}
+
+	predicted, err := nb.Predict([][]float64{{2, 5}, {7, 7}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predicted[0] != "a" || predicted[1] != "b" {
+		t.Errorf("Class Means Should Be Predicted As Their Own Class, Got %v", predicted)
+	}
+
+	probabilities, err := nb.PredictProbability([][]float64{{4, 6}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	total := float64(0)
+	for corpusClass, prob := range probabilities[0] {
+		if math.IsNaN(prob) {
+			t.Errorf("Probability Of %s Should Not Be NaN", corpusClass)
+		}
+		total += prob
+	}
+
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("Probability Should Sum To 1, Got %f", total)
+	}
+
+	_, err = nb.Predict([][]float64{{1}})
+
+	if err == nil || err.Error() != UnequalFeatureLength {
+		t.Errorf("Row With Missing Feature Should Return %s", UnequalFeatureLength)
+	}
+}
+
+func TestGaussianNaiveBayesZeroVariance(t *testing.T) {
+	nb := NewGaussianNaiveBayes(GaussianNaiveBayesConfig{})
+
+	err := nb.Fit([][]float64{{1}, {1}}, []string{"a", "b"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for _, corpusClass := range nb.GetClasses() {
+		if nb.GetVariance(corpusClass)[0] != DefaultVarianceSmooth {
+			t.Errorf("Variance Of A Constant Feature Should Fall Back To %g, Got %g",
+				DefaultVarianceSmooth, nb.GetVariance(corpusClass)[0])
+		}
+	}
+
+	probabilities, err := nb.PredictProbability([][]float64{{1}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if probabilities[0]["a"] != 0.5 || probabilities[0]["b"] != 0.5 {
+		t.Errorf("Identical Classes Should Share The Probability, Got %v", probabilities[0])
+	}
+}
@@ -0,0 +1,133 @@
+package naive_bayes
+
+import (
+	"github.com/adrian3ka/go-learn-ai/helper"
+	"math"
+)
+
+const (
+	ThresholdStrategy = "Threshold"
+	OneVsRestStrategy = "OneVsRest"
+
+	DefaultMultiLabelThreshold = 0.5
+)
+
+// MultiLabelConfig with ThresholdStrategy keeps every class whose probability reaches its
+// threshold, OneVsRestStrategy scores every class against all the other classes merged.
+// Classes missing from ClassThreshold use Threshold, which defaults to 0.5.
+type MultiLabelConfig struct {
+	Strategy       string
+	Threshold      float64
+	ClassThreshold map[string]float64
+}
+
+func (nb MultinomialNaiveBayes) PredictTopK(inputs []string, k int) ([]helper.PairList, error) {
+	probabilities, err := nb.PredictProbability(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []helper.PairList
+	for _, prob := range probabilities {
+		rankedClasses := rankClasses(prob)
+
+		if k > 0 && k < len(rankedClasses) {
+			rankedClasses = rankedClasses[0:k]
+		}
+
+		predicted = append(predicted, rankedClasses)
+	}
+
+	return predicted, nil
+}
+
+func (nb MultinomialNaiveBayes) getThreshold(class string) float64 {
+	if val, exists := nb.multiLabel.ClassThreshold[class]; exists {
+		return val
+	}
+
+	if nb.multiLabel.Threshold == 0 {
+		return DefaultMultiLabelThreshold
+	}
+
+	return nb.multiLabel.Threshold
+}
+
+func (nb MultinomialNaiveBayes) predictOneVsRestProbability(inputs []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := nb.evaluator.EvaluateInput(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dictionaryLength := float64(len(nb.evaluator.GetDictionary()))
+
+	allVectorData := make([]float64, int(dictionaryLength))
+	allData := float64(0)
+	for corpusClass := range nb.evaluator.GetTrainedData() {
+		for idx, val := range nb.evaluator.GetSumVectorDataOfClass(corpusClass) {
+			allVectorData[idx] += val
+		}
+		allData += nb.evaluator.GetSumDataOfClass(corpusClass)
+	}
+
+	logProbability := make(map[string][]float64)
+	restLogProbability := make(map[string][]float64)
+	for corpusClass := range nb.evaluator.GetTrainedData() {
+		classVectorData := nb.evaluator.GetSumVectorDataOfClass(corpusClass)
+		restVectorData := make([]float64, len(allVectorData))
+		for idx := range allVectorData {
+			restVectorData[idx] = allVectorData[idx] - classVectorData[idx]
+		}
+
+		classData := nb.evaluator.GetSumDataOfClass(corpusClass)
+		logProbability[corpusClass] = getLogProbability(classVectorData, classData, dictionaryLength)
+		restLogProbability[corpusClass] = getLogProbability(restVectorData, allData-classData, dictionaryLength)
+	}
+
+	var allPrediction []map[string]float64
+	for _, evaluatedInput := range evaluatedInputs {
+		predictedClass := make(map[string]float64)
+		for corpusClass := range logProbability {
+			difference := float64(0)
+			for idx, val := range evaluatedInput {
+				difference += val * (restLogProbability[corpusClass][idx] - logProbability[corpusClass][idx])
+			}
+			predictedClass[corpusClass] = 1 / (1 + math.Exp(difference))
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (nb MultinomialNaiveBayes) PredictMultiLabel(inputs []string) ([]helper.PairList, error) {
+	var probabilities []map[string]float64
+	var err error
+
+	if nb.multiLabel.Strategy == OneVsRestStrategy {
+		probabilities, err = nb.predictOneVsRestProbability(inputs)
+	} else {
+		probabilities, err = nb.PredictProbability(inputs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []helper.PairList
+	for _, prob := range probabilities {
+		var selectedClasses helper.PairList
+		for _, rankedClass := range rankClasses(prob) {
+			if rankedClass.Value >= nb.getThreshold(rankedClass.Key) {
+				selectedClasses = append(selectedClasses, rankedClass)
+			}
+		}
+
+		predicted = append(predicted, selectedClasses)
+	}
+
+	return predicted, nil
+}
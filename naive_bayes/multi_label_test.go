@@ -0,0 +1,124 @@
+package naive_bayes
+
+import (
+	"testing"
+)
+
+func TestRankClasses(t *testing.T) {
+	expected := []string{"b", "a", "c", "d"}
+	for run := 0; run < 10; run++ {
+		rankedClasses := rankClasses(map[string]float64{"c": 0.3, "a": 0.3, "d": 0, "b": 0.4})
+		for idx := range expected {
+			if rankedClasses[idx].Key != expected[idx] {
+				t.Errorf("Tied Classes Should Be Ranked By Name, Expected %v Got %v", expected, rankedClasses)
+				break
+			}
+		}
+	}
+}
+
+func TestPredictTopK(t *testing.T) {
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(ImbalancedDocuments),
+	})
+
+	err := nb.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	predicted, err := nb.PredictTopK([]string{"halo", "isi pulsa murah"}, 2)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(predicted[0]) != 2 || predicted[0][0].Key != "listrik" || predicted[0][1].Key != "pulsa" {
+		t.Errorf("Unknown Words Tie Every Class, Top 2 Should Be Listrik And Pulsa, Got %v", predicted[0])
+	}
+
+	if predicted[1][0].Key != "pulsa" || predicted[1][0].Value < predicted[1][1].Value {
+		t.Errorf("Top 1 Should Be Pulsa With The Highest Probability, Got %v", predicted[1])
+	}
+
+	for _, k := range []int{0, 5} {
+		predicted, err = nb.PredictTopK([]string{"halo"}, k)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if len(predicted[0]) != 3 {
+			t.Errorf("Top %d Should Return All 3 Classes, Got %d", k, len(predicted[0]))
+		}
+	}
+}
+
+func TestPredictMultiLabel(t *testing.T) {
+	testCases := []struct {
+		name       string
+		multiLabel MultiLabelConfig
+		expected   [][]string
+	}{
+		{
+			name:       "Default Threshold",
+			multiLabel: MultiLabelConfig{},
+			expected:   [][]string{nil, {"pulsa"}},
+		},
+		{
+			name: "Class Threshold",
+			multiLabel: MultiLabelConfig{
+				Threshold:      0.3,
+				ClassThreshold: map[string]float64{"tiket": 0.9},
+			},
+			expected: [][]string{{"listrik", "pulsa"}, {"pulsa"}},
+		},
+		{
+			name: "One Vs Rest",
+			multiLabel: MultiLabelConfig{
+				Strategy: OneVsRestStrategy,
+			},
+			// Without any known word every class scores 0.5 against the rest, which reaches the threshold.
+			expected: [][]string{{"listrik", "pulsa", "tiket"}, {"pulsa"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+			Evaluator:  newCountEvaluator(ImbalancedDocuments),
+			MultiLabel: testCase.multiLabel,
+		})
+
+		err := nb.Fit(ImbalancedDocuments, ImbalancedClasses)
+
+		if err != nil {
+			panic(err)
+		}
+
+		predicted, err := nb.PredictMultiLabel([]string{"halo", "isi pulsa murah"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx, expected := range testCase.expected {
+			var classes []string
+			for _, selectedClass := range predicted[idx] {
+				classes = append(classes, selectedClass.Key)
+			}
+
+			if len(classes) != len(expected) {
+				t.Errorf("%s Should Select %v, Got %v", testCase.name, expected, classes)
+				continue
+			}
+
+			for classIdx := range expected {
+				if classes[classIdx] != expected[classIdx] {
+					t.Errorf("%s Should Select %v, Got %v", testCase.name, expected, classes)
+					break
+				}
+			}
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package naive_bayes
 
 import (
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/helper"
 	"math"
+	"sort"
 )
 
 const (
@@ -9,7 +12,7 @@ const (
 )
 
 type EvaluatorInterface interface {
-	EvaluateInput(input interface{}) ([][]float64, error)
+	EvaluateInput(input []string) ([][]float64, error)
 	GetTrainedData() map[string][][]float64
 	GetDictionary() map[string]uint64
 	GetSumVectorDataOfClass(class string) []float64
@@ -17,22 +20,42 @@ type EvaluatorInterface interface {
 }
 
 type MultinomialNaiveBayesConfig struct {
-	Evaluator EvaluatorInterface
+	Evaluator  EvaluatorInterface
+	Rejection  RejectionConfig
+	MultiLabel MultiLabelConfig
 }
 
 type MultinomialNaiveBayes struct {
-	evaluator EvaluatorInterface
+	evaluator  EvaluatorInterface
+	rejection  RejectionConfig
+	multiLabel MultiLabelConfig
 }
 
 func NewMultinomialNaiveBayes(cfg MultinomialNaiveBayesConfig) MultinomialNaiveBayes {
 	multinomialNaiveBayes := MultinomialNaiveBayes{
-		evaluator: cfg.Evaluator,
+		evaluator:  cfg.Evaluator,
+		rejection:  cfg.Rejection,
+		multiLabel: cfg.MultiLabel,
 	}
 
 	return multinomialNaiveBayes
 }
 
-func (nb MultinomialNaiveBayes) Predict(inputs interface{}) ([]string, error) {
+var _ classifier.Classifier[string, string] = (*MultinomialNaiveBayes)(nil)
+
+func (nb *MultinomialNaiveBayes) Fit(documents []string, classes []string) error {
+	evaluator, err := newFittedEvaluator(nb.evaluator, documents, classes)
+
+	if err != nil {
+		return err
+	}
+
+	nb.evaluator = evaluator
+
+	return nil
+}
+
+func (nb MultinomialNaiveBayes) Predict(inputs []string) ([]string, error) {
 	var predicted []string
 	probabilities, err := nb.PredictProbability(inputs)
 
@@ -41,13 +64,9 @@ func (nb MultinomialNaiveBayes) Predict(inputs interface{}) ([]string, error) {
 	}
 
 	for _, prob := range probabilities {
-		highestProb := float64(0)
 		var selectedClass string
-		for key, value := range prob {
-			if highestProb < value {
-				selectedClass = key
-				highestProb = value
-			}
+		if rankedClasses := rankClasses(prob); len(rankedClasses) > 0 {
+			selectedClass = rankedClasses[0].Key
 		}
 		predicted = append(predicted, selectedClass)
 	}
@@ -55,7 +74,7 @@ func (nb MultinomialNaiveBayes) Predict(inputs interface{}) ([]string, error) {
 	return predicted, nil
 }
 
-func (nb MultinomialNaiveBayes) PredictProbability(inputs interface{}) ([]map[string]float64, error) {
+func (nb MultinomialNaiveBayes) PredictProbability(inputs []string) ([]map[string]float64, error) {
 	evaluatedInputs, err := nb.evaluator.EvaluateInput(inputs)
 
 	if err != nil {
@@ -90,3 +109,30 @@ func (nb MultinomialNaiveBayes) PredictProbability(inputs interface{}) ([]map[st
 
 	return allPrediction, nil
 }
+
+func getLogProbability(sumVectorData []float64, sumData float64, dictionaryLength float64) []float64 {
+	var logProbability []float64
+	for _, val := range sumVectorData {
+		logProbability = append(logProbability, math.Log((val+CONSTANT)/(sumData+dictionaryLength)))
+	}
+
+	return logProbability
+}
+
+func rankClasses(prob map[string]float64) helper.PairList {
+	var classes []string
+	for corpusClass := range prob {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	pl := make(helper.PairList, len(classes))
+	for idx, corpusClass := range classes {
+		pl[idx] = helper.Pair{Key: corpusClass, Value: prob[corpusClass]}
+	}
+	sort.SliceStable(pl, func(i, j int) bool {
+		return pl[i].Value > pl[j].Value
+	})
+
+	return pl
+}
@@ -0,0 +1,226 @@
+package naive_bayes
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math"
+	"sort"
+	"strings"
+)
+
+type Normalizer interface {
+	Normalize(document string) (string, error)
+}
+
+type OnlineNaiveBayesConfig struct {
+	Binary     bool
+	Normalizer Normalizer
+}
+
+type OnlineNaiveBayes struct {
+	binary           bool
+	normalizer       Normalizer
+	dictionary       map[string]uint64
+	documentPerClass map[string]float64
+	wordPerClass     map[string][]float64
+	sumWordPerClass  map[string]float64
+	totalDocument    float64
+}
+
+func NewOnlineNaiveBayes(cfg OnlineNaiveBayesConfig) *OnlineNaiveBayes {
+	nb := OnlineNaiveBayes{
+		binary:     cfg.Binary,
+		normalizer: cfg.Normalizer,
+	}
+	nb.reset()
+
+	return &nb
+}
+
+var _ classifier.Classifier[string, string] = (*OnlineNaiveBayes)(nil)
+
+func (nb *OnlineNaiveBayes) reset() {
+	nb.dictionary = make(map[string]uint64)
+	nb.documentPerClass = make(map[string]float64)
+	nb.wordPerClass = make(map[string][]float64)
+	nb.sumWordPerClass = make(map[string]float64)
+	nb.totalDocument = 0
+}
+
+func (nb *OnlineNaiveBayes) tokenize(document string) ([]string, error) {
+	if nb.normalizer != nil {
+		cleanedDocument, err := nb.normalizer.Normalize(document)
+
+		if err != nil {
+			return nil, err
+		}
+
+		document = cleanedDocument
+	}
+
+	var tokens []string
+	for _, word := range strings.Split(document, " ") {
+		if word != "" {
+			tokens = append(tokens, word)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (nb *OnlineNaiveBayes) Fit(documents []string, classes []string) error {
+	nb.reset()
+
+	return nb.PartialFit(documents, classes)
+}
+
+func (nb *OnlineNaiveBayes) PartialFit(documents []string, classes []string) error {
+	if len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	for idx, document := range documents {
+		tokens, err := nb.tokenize(document)
+
+		if err != nil {
+			return err
+		}
+
+		corpusClass := classes[idx]
+		seen := make(map[string]bool)
+		for _, word := range tokens {
+			if _, exists := nb.dictionary[word]; !exists {
+				nb.dictionary[word] = uint64(len(nb.dictionary))
+			}
+
+			if nb.binary && seen[word] {
+				continue
+			}
+			seen[word] = true
+
+			wordIndex := int(nb.dictionary[word])
+			for len(nb.wordPerClass[corpusClass]) <= wordIndex {
+				nb.wordPerClass[corpusClass] = append(nb.wordPerClass[corpusClass], 0)
+			}
+
+			nb.wordPerClass[corpusClass][wordIndex] += 1
+			nb.sumWordPerClass[corpusClass] += 1
+		}
+
+		nb.documentPerClass[corpusClass] += 1
+		nb.totalDocument += 1
+	}
+
+	return nil
+}
+
+func (nb *OnlineNaiveBayes) Predict(documents []string) ([]string, error) {
+	var predicted []string
+	probabilities, err := nb.PredictProbability(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	classes := nb.GetClasses()
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (nb *OnlineNaiveBayes) PredictProbability(documents []string) ([]map[string]float64, error) {
+	if nb.totalDocument == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	dictionaryLength := float64(len(nb.dictionary))
+	var allPrediction []map[string]float64
+
+	for _, document := range documents {
+		tokens, err := nb.tokenize(document)
+
+		if err != nil {
+			return nil, err
+		}
+
+		counter := make(map[int]float64)
+		for _, word := range tokens {
+			if wordIndex, exists := nb.dictionary[word]; exists {
+				if nb.binary {
+					counter[int(wordIndex)] = 1
+				} else {
+					counter[int(wordIndex)] += 1
+				}
+			}
+		}
+
+		logLikelihood := make(map[string]float64)
+		highestLogLikelihood := math.Inf(-1)
+		for corpusClass, documentCount := range nb.documentPerClass {
+			value := math.Log(documentCount / nb.totalDocument)
+			denominator := nb.sumWordPerClass[corpusClass] + dictionaryLength*CONSTANT
+			wordCount := nb.wordPerClass[corpusClass]
+
+			for wordIndex, count := range counter {
+				numerator := float64(CONSTANT)
+				if wordIndex < len(wordCount) {
+					numerator += wordCount[wordIndex]
+				}
+				value += count * math.Log(numerator/denominator)
+			}
+
+			logLikelihood[corpusClass] = value
+			highestLogLikelihood = math.Max(highestLogLikelihood, value)
+		}
+
+		denominator := float64(0)
+		predictedClass := make(map[string]float64)
+		for corpusClass, value := range logLikelihood {
+			predictedClass[corpusClass] = math.Exp(value - highestLogLikelihood)
+			denominator += predictedClass[corpusClass]
+		}
+
+		for corpusClass, predictedValue := range predictedClass {
+			predictedClass[corpusClass] = predictedValue / denominator
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (nb *OnlineNaiveBayes) GetClasses() []string {
+	var classes []string
+	for corpusClass := range nb.documentPerClass {
+		classes = append(classes, corpusClass)
+	}
+	sort.Strings(classes)
+
+	return classes
+}
+
+func (nb *OnlineNaiveBayes) GetDictionary() map[string]uint64 {
+	return nb.dictionary
+}
+
+func (nb *OnlineNaiveBayes) GetSumVectorDataOfClass(class string) []float64 {
+	vector := make([]float64, len(nb.dictionary))
+	copy(vector, nb.wordPerClass[class])
+
+	return vector
+}
+
+func (nb *OnlineNaiveBayes) GetSumDataOfClass(class string) float64 {
+	return nb.sumWordPerClass[class]
+}
@@ -0,0 +1,113 @@
+package naive_bayes
+
+import (
+	"github.com/adrian3ka/go-learn-ai/word_vectorizer"
+	"math"
+	"testing"
+)
+
+var (
+	Documents = []string{
+		"Saya mau beli pulsa dong. Jual voucher gak bang?. Mau isi pulsa dong.",
+		"jual pulsa gak ya?",
+		"kamu jual voucher ga?",
+		"kamu jual tiket pesawat ga?",
+		"disini jual tiket ga ya?",
+		"bisa beli tiket    kereta?",
+		"halo aku mau isi saldo dong",
+		"eh mau topup dong bisa ga?",
+		"mau nambah saldo dong bisa gak",
+	}
+	Classes = []string{
+		"pulsa", "pulsa", "pulsa",
+		"tiket", "tiket", "tiket",
+		"saldo", "saldo", "saldo",
+	}
+	NewDocuments = []string{
+		"ada kamar kosong ga ya di tempat kamu?",
+		"eh mau sewa 1 kamar dong",
+		"mau isi pulsa bisa ga ya?",
+	}
+	NewClasses = []string{
+		"hotel", "hotel", "pulsa",
+	}
+	DataTest = []string{
+		"mAu belI tiket kEreta doNg",
+		"jual pulsa ga ya?",
+		"mau topup isi wallet dong",
+		"mau sewa kamar",
+	}
+)
+
+func TestPartialFit(t *testing.T) {
+	wordVectorizer := word_vectorizer.New(word_vectorizer.WordVectorizerConfig{
+		Lower: true,
+	})
+
+	batch := NewOnlineNaiveBayes(OnlineNaiveBayesConfig{
+		Normalizer: wordVectorizer,
+	})
+
+	err := batch.Fit(append(append([]string{}, Documents...), NewDocuments...), append(append([]string{}, Classes...), NewClasses...))
+
+	if err != nil {
+		panic(err)
+	}
+
+	online := NewOnlineNaiveBayes(OnlineNaiveBayesConfig{
+		Normalizer: wordVectorizer,
+	})
+
+	err = online.PartialFit(Documents, Classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = online.PartialFit(NewDocuments, NewClasses)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(online.GetClasses()) != 4 {
+		t.Errorf("Class Count Should Be %d", 4)
+	}
+
+	if len(online.GetDictionary()) != len(batch.GetDictionary()) {
+		t.Errorf("Dictionary Length Should Be %d", len(batch.GetDictionary()))
+	}
+
+	batchProbabilities, err := batch.PredictProbability(DataTest)
+
+	if err != nil {
+		panic(err)
+	}
+
+	onlineProbabilities, err := online.PredictProbability(DataTest)
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx := range DataTest {
+		for class, prob := range batchProbabilities[idx] {
+			if math.Abs(prob-onlineProbabilities[idx][class]) > 1e-12 {
+				t.Errorf("Probability Of %s For %q Should Be %f", class, DataTest[idx], prob)
+			}
+		}
+	}
+
+	predicted, err := online.Predict(DataTest)
+
+	if err != nil {
+		panic(err)
+	}
+
+	expected := []string{"tiket", "pulsa", "saldo", "hotel"}
+	for idx := range expected {
+		if predicted[idx] != expected[idx] {
+			t.Errorf("Predicted Class Of %q Should Be %s", DataTest[idx], expected[idx])
+		}
+	}
+}
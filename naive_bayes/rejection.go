@@ -0,0 +1,154 @@
+package naive_bayes
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	UnknownLabel = "unknown"
+
+	AboveOutOfVocabularyRatio = "Above Out Of Vocabulary Ratio"
+	BelowProbabilityThreshold = "Below Probability Threshold"
+	BelowMarginThreshold      = "Below Margin Threshold"
+)
+
+// RejectionConfig leaves a check disabled when its value is zero. Without a Normalizer the
+// out of vocabulary ratio is computed on the input split by white space.
+type RejectionConfig struct {
+	ProbabilityThreshold float64
+	MarginThreshold      float64
+	OutOfVocabularyRatio float64
+	Normalizer           Normalizer
+}
+
+type RejectionResult struct {
+	Class                string
+	BestClass            string
+	Rejected             bool
+	Reason               string
+	Probability          float64
+	Margin               float64
+	OutOfVocabularyRatio float64
+}
+
+func (nb MultinomialNaiveBayes) getOutOfVocabularyRatio(input string) (float64, error) {
+	if nb.rejection.Normalizer != nil {
+		cleanedInput, err := nb.rejection.Normalizer.Normalize(input)
+
+		if err != nil {
+			return 0, err
+		}
+
+		input = cleanedInput
+	}
+
+	tokens := strings.Fields(input)
+	if len(tokens) == 0 {
+		return 1, nil
+	}
+
+	dictionary := nb.evaluator.GetDictionary()
+	outOfVocabulary := float64(0)
+	for _, token := range tokens {
+		if _, exists := dictionary[token]; !exists {
+			outOfVocabulary += 1
+		}
+	}
+
+	return outOfVocabulary / float64(len(tokens)), nil
+}
+
+// getPosterior computes the probability of every class with the log-sum-exp, the product of the
+// word probabilities of a long input underflows to zero and would give a NaN probability.
+func (nb MultinomialNaiveBayes) getPosterior(inputs []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := nb.evaluator.EvaluateInput(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	logProbability := make(map[string][]float64)
+	for corpusClass := range nb.evaluator.GetTrainedData() {
+		logProbability[corpusClass] = nb.getLogProbabilityOfClass(corpusClass)
+	}
+
+	var allPrediction []map[string]float64
+	for _, evaluatedInput := range evaluatedInputs {
+		predictedClass := make(map[string]float64)
+		highest := math.Inf(-1)
+		for corpusClass, classLogProbability := range logProbability {
+			score := float64(0)
+			for idx, val := range classLogProbability {
+				score += evaluatedInput[idx] * val
+			}
+
+			predictedClass[corpusClass] = score
+			highest = math.Max(highest, score)
+		}
+
+		denominator := float64(0)
+		for corpusClass, score := range predictedClass {
+			predictedClass[corpusClass] = math.Exp(score - highest)
+			denominator += predictedClass[corpusClass]
+		}
+
+		for corpusClass, predictedValue := range predictedClass {
+			predictedClass[corpusClass] = predictedValue / denominator
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (nb MultinomialNaiveBayes) PredictWithRejection(inputs []string) ([]RejectionResult, error) {
+	probabilities, err := nb.getPosterior(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var results []RejectionResult
+	for idx, prob := range probabilities {
+		rankedClasses := rankClasses(prob)
+
+		var result RejectionResult
+		if len(rankedClasses) > 0 {
+			result = RejectionResult{
+				Class:       rankedClasses[0].Key,
+				BestClass:   rankedClasses[0].Key,
+				Probability: rankedClasses[0].Value,
+				Margin:      rankedClasses[0].Value,
+			}
+		}
+
+		if len(rankedClasses) > 1 {
+			result.Margin -= rankedClasses[1].Value
+		}
+
+		result.OutOfVocabularyRatio, err = nb.getOutOfVocabularyRatio(inputs[idx])
+
+		if err != nil {
+			return nil, err
+		}
+
+		if nb.rejection.OutOfVocabularyRatio > 0 && result.OutOfVocabularyRatio > nb.rejection.OutOfVocabularyRatio {
+			result.Reason = AboveOutOfVocabularyRatio
+		} else if result.Probability < nb.rejection.ProbabilityThreshold {
+			result.Reason = BelowProbabilityThreshold
+		} else if result.Margin < nb.rejection.MarginThreshold {
+			result.Reason = BelowMarginThreshold
+		}
+
+		if result.Reason != "" {
+			result.Class = UnknownLabel
+			result.Rejected = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,161 @@
+package naive_bayes
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// countEvaluator counts the words of its dictionary in every document, Fit of the models
+// replaces its empty trained data.
+type countEvaluator struct {
+	dictionary map[string]uint64
+}
+
+func newCountEvaluator(documents []string) countEvaluator {
+	dictionary := make(map[string]uint64)
+	for _, document := range documents {
+		for _, word := range strings.Fields(document) {
+			if _, exists := dictionary[word]; !exists {
+				dictionary[word] = uint64(len(dictionary))
+			}
+		}
+	}
+	return countEvaluator{dictionary: dictionary}
+}
+
+func (ce countEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	var evaluatedInput [][]float64
+	for _, document := range input {
+		vector := make([]float64, len(ce.dictionary))
+		for _, word := range strings.Fields(document) {
+			if idx, exists := ce.dictionary[word]; exists {
+				vector[idx] += 1
+			}
+		}
+		evaluatedInput = append(evaluatedInput, vector)
+	}
+	return evaluatedInput, nil
+}
+
+func (ce countEvaluator) GetTrainedData() map[string][][]float64 {
+	return map[string][][]float64{}
+}
+
+func (ce countEvaluator) GetDictionary() map[string]uint64 {
+	return ce.dictionary
+}
+
+func (ce countEvaluator) GetSumVectorDataOfClass(class string) []float64 {
+	return nil
+}
+
+func (ce countEvaluator) GetSumDataOfClass(class string) float64 {
+	return 0
+}
+
+func TestPredictWithRejection(t *testing.T) {
+	documents := []string{"beli pulsa", "isi pulsa", "pesan tiket", "beli tiket kereta"}
+	classes := []string{"pulsa", "pulsa", "tiket", "tiket"}
+
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(documents),
+		Rejection: RejectionConfig{
+			MarginThreshold:      0.3,
+			OutOfVocabularyRatio: 0.5,
+		},
+	})
+
+	err := nb.Fit(documents, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	results, err := nb.PredictWithRejection([]string{"pulsa pulsa", "halo apa kabar pulsa", "beli"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if results[0].Rejected || results[0].Class != "pulsa" {
+		t.Errorf("Confident Known Input Should Not Be Rejected, Got %+v", results[0])
+	}
+
+	if !results[1].Rejected || results[1].Reason != AboveOutOfVocabularyRatio || results[1].OutOfVocabularyRatio != 0.75 {
+		t.Errorf("Input With 3 Of 4 Unknown Words Should Be Rejected As %s, Got %+v", AboveOutOfVocabularyRatio, results[1])
+	}
+
+	if !results[2].Rejected || results[2].Reason != BelowMarginThreshold || results[2].Margin >= 0.3 {
+		t.Errorf("Word Shared By Both Classes Should Be Rejected As %s, Got %+v", BelowMarginThreshold, results[2])
+	}
+
+	for _, result := range results[1:] {
+		if result.Class != UnknownLabel || result.BestClass != "pulsa" {
+			t.Errorf("Rejected Input Should Be %s And Keep Its Best Class, Got %+v", UnknownLabel, result)
+		}
+	}
+
+	unfitted := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(documents),
+		Rejection: RejectionConfig{
+			ProbabilityThreshold: 0.5,
+		},
+	})
+
+	results, err = unfitted.PredictWithRejection([]string{"beli pulsa"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !results[0].Rejected || results[0].Reason != BelowProbabilityThreshold || results[0].BestClass != "" {
+		t.Errorf("Model Without Classes Should Reject As %s, Got %+v", BelowProbabilityThreshold, results[0])
+	}
+}
+
+func TestPredictWithRejectionLongInput(t *testing.T) {
+	documents := []string{"beli pulsa", "beli tiket"}
+	classes := []string{"pulsa", "tiket"}
+
+	nb := NewMultinomialNaiveBayes(MultinomialNaiveBayesConfig{
+		Evaluator: newCountEvaluator(documents),
+		Rejection: RejectionConfig{
+			ProbabilityThreshold: 0.6,
+			MarginThreshold:      0.3,
+		},
+	})
+
+	err := nb.Fit(documents, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// Beli is as likely in both classes, 0.4 to the power of 1000 underflows to zero.
+	inputs := []string{strings.Repeat("beli ", 1000), strings.Repeat("pulsa ", 1000)}
+
+	probabilities, err := nb.PredictProbability(inputs[0:1])
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !math.IsNaN(probabilities[0]["pulsa"]) {
+		t.Errorf("Product Of The Word Probabilities Should Underflow For This Input, Got %v", probabilities[0])
+	}
+
+	results, err := nb.PredictWithRejection(inputs)
+
+	if err != nil {
+		panic(err)
+	}
+
+	if !results[0].Rejected || results[0].Probability != 0.5 || results[0].Margin != 0 {
+		t.Errorf("Long Input Tied Between The Classes Should Be Rejected, Got %+v", results[0])
+	}
+
+	if results[1].Rejected || results[1].Class != "pulsa" || results[1].Probability != 1 {
+		t.Errorf("Long Pulsa Input Should Be Accepted As Pulsa, Got %+v", results[1])
+	}
+}
@@ -0,0 +1,348 @@
+package neural_network
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"github.com/adrian3ka/go-learn-ai/layer"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	InvalidDataLearn     = "Invalid Data Learn"
+	UnequalFeatureLength = "Unequal Feature Length"
+	ModelNotFitted       = "Model Not Fitted"
+	EvaluatorNil         = "Evaluator is Nil"
+	InvalidLayerSize     = "Invalid Layer Size"
+	UnknownActivation    = "Unknown Activation"
+
+	DefaultHiddenLayerSize = 100
+	DefaultLearningRate    = 0.1
+	DefaultBatchSize       = 32
+	DefaultMaxIteration    = 100
+)
+
+// ActivationFunctionType is the activation of the layer package, so the perceptron can be
+// configured without importing it.
+type ActivationFunctionType = layer.ActivationFunctionType
+
+const (
+	ReLU    = layer.ReLU
+	Sigmoid = layer.Sigmoid
+	Tanh    = layer.Tanh
+	Softmax = layer.Softmax
+)
+
+type Evaluator interface {
+	EvaluateInput(input []string) ([][]float64, error)
+}
+
+// MultilayerPerceptronConfig stacks a dense layer for every size of HiddenLayer, all of them using
+// Activation, followed by a softmax output layer trained on the cross entropy.
+type MultilayerPerceptronConfig struct {
+	Evaluator    Evaluator
+	HiddenLayer  []int
+	Activation   ActivationFunctionType
+	LearningRate float64
+	BatchSize    int
+	MaxIteration int
+	L2           float64
+	Seed         int64
+}
+
+type MultilayerPerceptron struct {
+	evaluator    Evaluator
+	hiddenLayer  []int
+	activation   ActivationFunctionType
+	learningRate float64
+	batchSize    int
+	maxIteration int
+	l2           float64
+	seed         int64
+	classes      []string
+	layers       []*layer.Layer
+	trainingLoss []float64
+}
+
+func NewMultilayerPerceptron(cfg MultilayerPerceptronConfig) (*MultilayerPerceptron, error) {
+	if cfg.Evaluator == nil {
+		return nil, errors.New(EvaluatorNil)
+	}
+
+	if cfg.HiddenLayer == nil {
+		cfg.HiddenLayer = []int{DefaultHiddenLayerSize}
+	}
+
+	for _, size := range cfg.HiddenLayer {
+		if size <= 0 {
+			return nil, errors.New(InvalidLayerSize)
+		}
+	}
+
+	if cfg.Activation == "" {
+		cfg.Activation = ReLU
+	}
+
+	if cfg.Activation != ReLU && cfg.Activation != Sigmoid && cfg.Activation != Tanh {
+		return nil, errors.New(UnknownActivation)
+	}
+
+	if cfg.LearningRate == 0 {
+		cfg.LearningRate = DefaultLearningRate
+	}
+
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+
+	if cfg.MaxIteration == 0 {
+		cfg.MaxIteration = DefaultMaxIteration
+	}
+
+	return &MultilayerPerceptron{
+		evaluator:    cfg.Evaluator,
+		hiddenLayer:  cfg.HiddenLayer,
+		activation:   cfg.Activation,
+		learningRate: cfg.LearningRate,
+		batchSize:    cfg.BatchSize,
+		maxIteration: cfg.MaxIteration,
+		l2:           cfg.L2,
+		seed:         cfg.Seed,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*MultilayerPerceptron)(nil)
+
+func (mlp *MultilayerPerceptron) Fit(documents []string, classes []string) error {
+	if len(documents) == 0 || len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	evaluatedInputs, err := mlp.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return err
+	}
+
+	return mlp.FitVector(evaluatedInputs, classes)
+}
+
+func (mlp *MultilayerPerceptron) FitVector(inputs [][]float64, classes []string) error {
+	if len(inputs) == 0 || len(inputs) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	featureLength := len(inputs[0])
+	for _, input := range inputs {
+		if len(input) != featureLength {
+			return errors.New(UnequalFeatureLength)
+		}
+	}
+
+	classIndex := make(map[string]int)
+	mlp.classes = nil
+	for _, corpusClass := range classes {
+		if _, exists := classIndex[corpusClass]; !exists {
+			classIndex[corpusClass] = 0
+			mlp.classes = append(mlp.classes, corpusClass)
+		}
+	}
+	sort.Strings(mlp.classes)
+	for idx, corpusClass := range mlp.classes {
+		classIndex[corpusClass] = idx
+	}
+
+	targets := make([]int, len(classes))
+	for idx, corpusClass := range classes {
+		targets[idx] = classIndex[corpusClass]
+	}
+
+	random := rand.New(rand.NewSource(mlp.seed))
+
+	mlp.layers = nil
+	inputSize := featureLength
+	for _, size := range mlp.hiddenLayer {
+		hiddenLayer, err := layer.NewDenseLayer(inputSize, size, mlp.activation, random)
+
+		if err != nil {
+			return err
+		}
+
+		mlp.layers = append(mlp.layers, hiddenLayer)
+		inputSize = size
+	}
+
+	outputLayer, err := layer.NewDenseLayer(inputSize, len(mlp.classes), Softmax, random)
+
+	if err != nil {
+		return err
+	}
+
+	mlp.layers = append(mlp.layers, outputLayer)
+	mlp.trainingLoss = nil
+
+	for iteration := 0; iteration < mlp.maxIteration; iteration++ {
+		order := random.Perm(len(inputs))
+
+		loss := float64(0)
+		for start := 0; start < len(order); start += mlp.batchSize {
+			end := start + mlp.batchSize
+			if end > len(order) {
+				end = len(order)
+			}
+
+			var batchInputs [][]float64
+			var batchTargets []int
+			for _, idx := range order[start:end] {
+				batchInputs = append(batchInputs, inputs[idx])
+				batchTargets = append(batchTargets, targets[idx])
+			}
+
+			batchLoss, err := mlp.train(batchInputs, batchTargets)
+
+			if err != nil {
+				return err
+			}
+
+			loss += batchLoss
+		}
+
+		mlp.trainingLoss = append(mlp.trainingLoss, loss/float64(len(inputs)))
+	}
+
+	return nil
+}
+
+// train runs the forward and the backward pass of a single batch and returns its summed cross entropy.
+func (mlp *MultilayerPerceptron) train(inputs [][]float64, targets []int) (float64, error) {
+	output, err := mlp.forward(inputs)
+
+	if err != nil {
+		return 0, err
+	}
+
+	// The derivative of the cross entropy through the softmax is the probability minus the target.
+	loss := float64(0)
+	delta := make([][]float64, len(output))
+	for row := range output {
+		loss -= math.Log(math.Max(output[row][targets[row]], 1e-15))
+
+		delta[row] = make([]float64, len(output[row]))
+		for idx, prob := range output[row] {
+			delta[row][idx] = prob / float64(len(inputs))
+		}
+		delta[row][targets[row]] -= 1 / float64(len(inputs))
+	}
+
+	for idx := len(mlp.layers) - 1; idx >= 0; idx-- {
+		inputGradient := mlp.layers[idx].Backward(delta, mlp.learningRate, mlp.l2)
+
+		if idx == 0 {
+			break
+		}
+
+		previous := mlp.layers[idx-1]
+		for row := range inputGradient {
+			for col, val := range previous.Value[row] {
+				inputGradient[row][col] *= layer.Derivative(previous.Activation, val)
+			}
+		}
+		delta = inputGradient
+	}
+
+	return loss, nil
+}
+
+func (mlp *MultilayerPerceptron) forward(inputs [][]float64) ([][]float64, error) {
+	output := inputs
+	for _, denseLayer := range mlp.layers {
+		var err error
+		output, err = denseLayer.Forward(output)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (mlp *MultilayerPerceptron) Predict(documents []string) ([]string, error) {
+	var predicted []string
+	probabilities, err := mlp.PredictProbability(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prob := range probabilities {
+		highestProb := float64(-1)
+		var selectedClass string
+		for _, corpusClass := range mlp.classes {
+			if highestProb < prob[corpusClass] {
+				selectedClass = corpusClass
+				highestProb = prob[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (mlp *MultilayerPerceptron) PredictProbability(documents []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := mlp.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mlp.PredictVectorProbability(evaluatedInputs)
+}
+
+func (mlp *MultilayerPerceptron) PredictVectorProbability(inputs [][]float64) ([]map[string]float64, error) {
+	if len(mlp.layers) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	for _, input := range inputs {
+		if len(input) != len(mlp.layers[0].Weight) {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+	}
+
+	output, err := mlp.forward(inputs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var allPrediction []map[string]float64
+	for _, row := range output {
+		predictedClass := make(map[string]float64)
+		for idx, prob := range row {
+			predictedClass[mlp.classes[idx]] = prob
+		}
+
+		allPrediction = append(allPrediction, predictedClass)
+	}
+
+	return allPrediction, nil
+}
+
+func (mlp *MultilayerPerceptron) GetClasses() []string {
+	return mlp.classes
+}
+
+func (mlp *MultilayerPerceptron) GetLayers() []*layer.Layer {
+	return mlp.layers
+}
+
+func (mlp *MultilayerPerceptron) GetTrainingLoss() []float64 {
+	return mlp.trainingLoss
+}
@@ -0,0 +1,121 @@
+package neural_network
+
+import (
+	"testing"
+)
+
+type vectorEvaluator struct{}
+
+func (ve vectorEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	var evaluatedInput [][]float64
+	for _, document := range input {
+		vector := make([]float64, 3)
+		for _, character := range document {
+			vector[character-'a'] += 1
+		}
+		evaluatedInput = append(evaluatedInput, vector)
+	}
+	return evaluatedInput, nil
+}
+
+func TestBasic(t *testing.T) {
+	documents := []string{"a", "aa", "ab", "b", "bb", "bc", "c", "cc", "ca"}
+	classes := []string{"x", "x", "x", "y", "y", "y", "z", "z", "z"}
+
+	mlp, err := NewMultilayerPerceptron(MultilayerPerceptronConfig{
+		Evaluator:    vectorEvaluator{},
+		HiddenLayer:  []int{8, 8},
+		BatchSize:    3,
+		MaxIteration: 300,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = mlp.Fit(documents, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	predicted, err := mlp.Predict([]string{"aaa", "bbb", "ccc"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for idx, expected := range []string{"x", "y", "z"} {
+		if predicted[idx] != expected {
+			t.Errorf("Prediction Should Be %s", expected)
+		}
+	}
+
+	probabilities, err := mlp.PredictProbability([]string{"abc"})
+
+	if err != nil {
+		panic(err)
+	}
+
+	sum := float64(0)
+	for _, prob := range probabilities[0] {
+		sum += prob
+	}
+
+	if sum < 0.999999 || sum > 1.000001 {
+		t.Errorf("Probability Should Sum To 1")
+	}
+
+	_, err = NewMultilayerPerceptron(MultilayerPerceptronConfig{
+		Evaluator:  vectorEvaluator{},
+		Activation: Softmax,
+	})
+
+	if err == nil || err.Error() != UnknownActivation {
+		t.Errorf("Softmax Hidden Layer Should Return %s", UnknownActivation)
+	}
+}
+
+func TestXor(t *testing.T) {
+	inputs := [][]float64{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+	classes := []string{"false", "true", "true", "false"}
+
+	for _, activation := range []ActivationFunctionType{ReLU, Sigmoid, Tanh} {
+		mlp, err := NewMultilayerPerceptron(MultilayerPerceptronConfig{
+			Evaluator:    vectorEvaluator{},
+			HiddenLayer:  []int{8},
+			Activation:   activation,
+			LearningRate: 0.5,
+			BatchSize:    4,
+			MaxIteration: 2000,
+			Seed:         1,
+		})
+
+		if err != nil {
+			panic(err)
+		}
+
+		err = mlp.FitVector(inputs, classes)
+
+		if err != nil {
+			panic(err)
+		}
+
+		trainingLoss := mlp.GetTrainingLoss()
+		if trainingLoss[len(trainingLoss)-1] >= trainingLoss[0] {
+			t.Errorf("%s Training Loss Should Decrease", activation)
+		}
+
+		probabilities, err := mlp.PredictVectorProbability(inputs)
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx, prob := range probabilities {
+			if prob[classes[idx]] < 0.5 {
+				t.Errorf("%s Should Learn Xor Of %v", activation, inputs[idx])
+			}
+		}
+	}
+}
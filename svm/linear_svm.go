@@ -0,0 +1,368 @@
+package svm
+
+import (
+	"errors"
+	"github.com/adrian3ka/go-learn-ai/classifier"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	InvalidDataLearn     = "Invalid Data Learn"
+	UnequalFeatureLength = "Unequal Feature Length"
+	ModelNotFitted       = "Model Not Fitted"
+	EvaluatorNil         = "Evaluator is Nil"
+	UnknownLoss          = "Unknown Loss"
+	UnknownSolver        = "Unknown Solver"
+
+	Hinge        = "Hinge"
+	SquaredHinge = "SquaredHinge"
+
+	DualCoordinateDescent = "DualCoordinateDescent"
+	Pegasos               = "Pegasos"
+
+	DefaultC            = 1
+	DefaultMaxIteration = 1000
+	DefaultTolerance    = 1e-4
+)
+
+type Evaluator interface {
+	EvaluateInput(input []string) ([][]float64, error)
+}
+
+type LinearSVMConfig struct {
+	Evaluator    Evaluator
+	Loss         string
+	Solver       string
+	C            float64
+	MaxIteration int
+	Tolerance    float64
+	Seed         int64
+}
+
+type LinearSVM struct {
+	evaluator    Evaluator
+	loss         string
+	solver       string
+	c            float64
+	maxIteration int
+	tolerance    float64
+	seed         int64
+	classes      []string
+	weight       [][]float64
+	bias         []float64
+}
+
+func NewLinearSVM(cfg LinearSVMConfig) (*LinearSVM, error) {
+	if cfg.Evaluator == nil {
+		return nil, errors.New(EvaluatorNil)
+	}
+
+	if cfg.Loss == "" {
+		cfg.Loss = SquaredHinge
+	}
+
+	if cfg.Loss != Hinge && cfg.Loss != SquaredHinge {
+		return nil, errors.New(UnknownLoss)
+	}
+
+	if cfg.Solver == "" {
+		cfg.Solver = DualCoordinateDescent
+	}
+
+	if cfg.Solver != DualCoordinateDescent && cfg.Solver != Pegasos {
+		return nil, errors.New(UnknownSolver)
+	}
+
+	if cfg.C == 0 {
+		cfg.C = DefaultC
+	}
+
+	if cfg.MaxIteration == 0 {
+		cfg.MaxIteration = DefaultMaxIteration
+	}
+
+	if cfg.Tolerance == 0 {
+		cfg.Tolerance = DefaultTolerance
+	}
+
+	return &LinearSVM{
+		evaluator:    cfg.Evaluator,
+		loss:         cfg.Loss,
+		solver:       cfg.Solver,
+		c:            cfg.C,
+		maxIteration: cfg.MaxIteration,
+		tolerance:    cfg.Tolerance,
+		seed:         cfg.Seed,
+	}, nil
+}
+
+var _ classifier.Classifier[string, string] = (*LinearSVM)(nil)
+
+func (svm *LinearSVM) Fit(documents []string, classes []string) error {
+	if len(documents) == 0 || len(documents) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	evaluatedInputs, err := svm.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return err
+	}
+
+	return svm.FitVector(evaluatedInputs, classes)
+}
+
+func (svm *LinearSVM) FitVector(inputs [][]float64, classes []string) error {
+	if len(inputs) == 0 || len(inputs) != len(classes) {
+		return errors.New(InvalidDataLearn)
+	}
+
+	featureLength := len(inputs[0])
+	for _, input := range inputs {
+		if len(input) != featureLength {
+			return errors.New(UnequalFeatureLength)
+		}
+	}
+
+	uniqueClasses := make(map[string]bool)
+	svm.classes = nil
+	for _, corpusClass := range classes {
+		if !uniqueClasses[corpusClass] {
+			uniqueClasses[corpusClass] = true
+			svm.classes = append(svm.classes, corpusClass)
+		}
+	}
+	sort.Strings(svm.classes)
+
+	svm.weight = make([][]float64, len(svm.classes))
+	svm.bias = make([]float64, len(svm.classes))
+
+	random := rand.New(rand.NewSource(svm.seed))
+	for classIdx, corpusClass := range svm.classes {
+		targets := make([]float64, len(classes))
+		for idx := range classes {
+			if classes[idx] == corpusClass {
+				targets[idx] = 1
+			} else {
+				targets[idx] = -1
+			}
+		}
+
+		if svm.solver == Pegasos {
+			svm.weight[classIdx], svm.bias[classIdx] = svm.trainPegasos(inputs, targets, random)
+		} else {
+			svm.weight[classIdx], svm.bias[classIdx] = svm.trainDualCoordinateDescent(inputs, targets, random)
+		}
+	}
+
+	return nil
+}
+
+// trainDualCoordinateDescent solves the dual problem of the binary SVM one coordinate at a time
+// (Hsieh et al., 2008). The bias is learned as the weight of an extra feature fixed to 1.
+func (svm *LinearSVM) trainDualCoordinateDescent(inputs [][]float64, targets []float64, random *rand.Rand) ([]float64, float64) {
+	upperBound := svm.c
+	diagonal := float64(0)
+	if svm.loss == SquaredHinge {
+		upperBound = math.Inf(1)
+		diagonal = 1 / (2 * svm.c)
+	}
+
+	weight := make([]float64, len(inputs[0]))
+	bias := float64(0)
+	alpha := make([]float64, len(inputs))
+
+	quadratic := make([]float64, len(inputs))
+	for idx, input := range inputs {
+		quadratic[idx] = dot(input, input) + 1 + diagonal
+	}
+
+	for iteration := 0; iteration < svm.maxIteration; iteration++ {
+		highestGradient := math.Inf(-1)
+		lowestGradient := math.Inf(1)
+
+		for _, idx := range random.Perm(len(inputs)) {
+			gradient := targets[idx]*(dot(weight, inputs[idx])+bias) - 1 + diagonal*alpha[idx]
+
+			projectedGradient := gradient
+			if alpha[idx] == 0 {
+				projectedGradient = math.Min(gradient, 0)
+			} else if alpha[idx] == upperBound {
+				projectedGradient = math.Max(gradient, 0)
+			}
+
+			highestGradient = math.Max(highestGradient, projectedGradient)
+			lowestGradient = math.Min(lowestGradient, projectedGradient)
+
+			if math.Abs(projectedGradient) > 1e-12 {
+				previousAlpha := alpha[idx]
+				alpha[idx] = math.Min(math.Max(alpha[idx]-gradient/quadratic[idx], 0), upperBound)
+
+				step := (alpha[idx] - previousAlpha) * targets[idx]
+				for featureIdx, val := range inputs[idx] {
+					if val != 0 {
+						weight[featureIdx] += step * val
+					}
+				}
+				bias += step
+			}
+		}
+
+		if highestGradient-lowestGradient < svm.tolerance {
+			break
+		}
+	}
+
+	return weight, bias
+}
+
+// trainPegasos runs stochastic sub-gradient descent on the primal problem (Shalev-Shwartz et al., 2007)
+// with lambda = 1 / (C * n), so C keeps the same meaning as in the dual solver.
+func (svm *LinearSVM) trainPegasos(inputs [][]float64, targets []float64, random *rand.Rand) ([]float64, float64) {
+	lambda := 1 / (svm.c * float64(len(inputs)))
+
+	weight := make([]float64, len(inputs[0]))
+	bias := float64(0)
+
+	step := 0
+	for iteration := 0; iteration < svm.maxIteration; iteration++ {
+		for _, idx := range random.Perm(len(inputs)) {
+			step++
+			learningRate := 1 / (lambda * float64(step))
+			margin := targets[idx] * (dot(weight, inputs[idx]) + bias)
+
+			for featureIdx := range weight {
+				weight[featureIdx] *= 1 - learningRate*lambda
+			}
+
+			if margin < 1 {
+				gradient := targets[idx]
+				if svm.loss == SquaredHinge {
+					gradient *= 2 * (1 - margin)
+				}
+
+				for featureIdx, val := range inputs[idx] {
+					if val != 0 {
+						weight[featureIdx] += learningRate * gradient * val
+					}
+				}
+
+				// The bias is not regularized, so 1 / (lambda * t) would swing it far on the first
+				// steps. Its step is bounded to 1 until the learning rate drops below it.
+				bias += math.Min(learningRate, 1) * gradient
+			}
+
+			// The optimum lies inside the ball of radius sqrt(2 / lambda), projecting on it keeps the
+			// early large steps from blowing up the weight.
+			norm := math.Sqrt(dot(weight, weight))
+			if radius := math.Sqrt(2 / lambda); norm > radius {
+				for featureIdx := range weight {
+					weight[featureIdx] *= radius / norm
+				}
+			}
+		}
+	}
+
+	return weight, bias
+}
+
+func (svm *LinearSVM) Predict(documents []string) ([]string, error) {
+	decisions, err := svm.DecisionFunction(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var predicted []string
+	for _, decision := range decisions {
+		highestDecision := math.Inf(-1)
+		var selectedClass string
+		for _, corpusClass := range svm.classes {
+			if highestDecision < decision[corpusClass] {
+				selectedClass = corpusClass
+				highestDecision = decision[corpusClass]
+			}
+		}
+		predicted = append(predicted, selectedClass)
+	}
+
+	return predicted, nil
+}
+
+func (svm *LinearSVM) DecisionFunction(documents []string) ([]map[string]float64, error) {
+	evaluatedInputs, err := svm.evaluator.EvaluateInput(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return svm.VectorDecisionFunction(evaluatedInputs)
+}
+
+func (svm *LinearSVM) VectorDecisionFunction(inputs [][]float64) ([]map[string]float64, error) {
+	if len(svm.classes) == 0 {
+		return nil, errors.New(ModelNotFitted)
+	}
+
+	var decisions []map[string]float64
+	for _, input := range inputs {
+		if len(input) != len(svm.weight[0]) {
+			return nil, errors.New(UnequalFeatureLength)
+		}
+
+		decision := make(map[string]float64)
+		for classIdx, corpusClass := range svm.classes {
+			decision[corpusClass] = dot(svm.weight[classIdx], input) + svm.bias[classIdx]
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+// PredictProbability squashes the decision values with a softmax, they only rank the classes
+// and are not calibrated probabilities.
+func (svm *LinearSVM) PredictProbability(documents []string) ([]map[string]float64, error) {
+	decisions, err := svm.DecisionFunction(documents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decision := range decisions {
+		highestDecision := math.Inf(-1)
+		for _, val := range decision {
+			highestDecision = math.Max(highestDecision, val)
+		}
+
+		denominator := float64(0)
+		for corpusClass, val := range decision {
+			decision[corpusClass] = math.Exp(val - highestDecision)
+			denominator += decision[corpusClass]
+		}
+
+		for corpusClass, val := range decision {
+			decision[corpusClass] = val / denominator
+		}
+	}
+
+	return decisions, nil
+}
+
+func (svm *LinearSVM) GetClasses() []string {
+	return svm.classes
+}
+
+func dot(a, b []float64) float64 {
+	result := float64(0)
+	for idx := range a {
+		if a[idx] != 0 {
+			result += a[idx] * b[idx]
+		}
+	}
+
+	return result
+}
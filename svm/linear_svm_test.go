@@ -0,0 +1,141 @@
+package svm
+
+import (
+	"math"
+	"testing"
+)
+
+type vectorEvaluator struct{}
+
+func (ve vectorEvaluator) EvaluateInput(input []string) ([][]float64, error) {
+	var evaluatedInput [][]float64
+	for _, document := range input {
+		vector := make([]float64, 3)
+		for _, character := range document {
+			vector[character-'a'] += 1
+		}
+		evaluatedInput = append(evaluatedInput, vector)
+	}
+	return evaluatedInput, nil
+}
+
+func TestLinearSVM(t *testing.T) {
+	documents := []string{"a", "aa", "ab", "b", "bb", "bc", "c", "cc", "ca"}
+	classes := []string{"x", "x", "x", "y", "y", "y", "z", "z", "z"}
+
+	for _, solver := range []string{DualCoordinateDescent, Pegasos} {
+		for _, loss := range []string{Hinge, SquaredHinge} {
+			svm, err := NewLinearSVM(LinearSVMConfig{
+				Evaluator:    vectorEvaluator{},
+				Solver:       solver,
+				Loss:         loss,
+				MaxIteration: 200,
+				Seed:         1,
+			})
+
+			if err != nil {
+				panic(err)
+			}
+
+			err = svm.Fit(documents, classes)
+
+			if err != nil {
+				panic(err)
+			}
+
+			predicted, err := svm.Predict(append(documents, "aaa", "bbb", "ccc"))
+
+			if err != nil {
+				panic(err)
+			}
+
+			for idx, expected := range append(classes, "x", "y", "z") {
+				if predicted[idx] != expected {
+					t.Errorf("%s %s Should Predict Row %d As %s, Got %s", solver, loss, idx, expected, predicted[idx])
+				}
+			}
+
+			probabilities, err := svm.PredictProbability([]string{"abc"})
+
+			if err != nil {
+				panic(err)
+			}
+
+			sum := float64(0)
+			for _, prob := range probabilities[0] {
+				sum += prob
+			}
+
+			if math.Abs(sum-1) > 1e-9 {
+				t.Errorf("%s %s Probability Should Sum To 1", solver, loss)
+			}
+		}
+	}
+}
+
+func TestPegasosBias(t *testing.T) {
+	inputs := [][]float64{{1}, {2}, {3}, {-1}, {-2}, {-3}}
+	classes := []string{"x", "x", "x", "y", "y", "y"}
+
+	svm, err := NewLinearSVM(LinearSVMConfig{
+		Evaluator:    vectorEvaluator{},
+		Solver:       Pegasos,
+		Loss:         Hinge,
+		C:            10,
+		MaxIteration: 20,
+		Seed:         1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = svm.FitVector(inputs, classes)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The data is symmetric around zero, so the separating hyperplane has no bias. A short run
+	// shows whether the first steps threw the bias off.
+	for classIdx, bias := range svm.bias {
+		if math.Abs(bias) > 0.5 {
+			t.Errorf("Pegasos Bias Of Class %s Should Stay Close To 0, Got %f", svm.classes[classIdx], bias)
+		}
+	}
+
+	predicted, err := svm.VectorDecisionFunction([][]float64{{0.5}, {-0.5}})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if predicted[0]["x"] <= 0 || predicted[1]["y"] <= 0 {
+		t.Errorf("Points Close To The Boundary Should Still Be On Their Side, Got %+v", predicted)
+	}
+}
+
+func TestLinearSVMConfig(t *testing.T) {
+	_, err := NewLinearSVM(LinearSVMConfig{
+		Evaluator: vectorEvaluator{},
+		Solver:    "SMO",
+	})
+
+	if err == nil || err.Error() != UnknownSolver {
+		t.Errorf("Unknown Solver Should Return %s", UnknownSolver)
+	}
+
+	svm, err := NewLinearSVM(LinearSVMConfig{
+		Evaluator: vectorEvaluator{},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = svm.Predict([]string{"a"})
+
+	if err == nil || err.Error() != ModelNotFitted {
+		t.Errorf("Unfitted Model Should Return %s", ModelNotFitted)
+	}
+}
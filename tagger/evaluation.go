@@ -0,0 +1,217 @@
+package tagger
+
+import (
+	"errors"
+	"fmt"
+	"github.com/adrian3ka/go-learn-ai/metrics"
+	"sort"
+	"strings"
+)
+
+const (
+	EmptyTestData        = "Empty Test Data"
+	UnequalPredictLength = "Unequal Predict Length"
+
+	DefaultErrorCount   = 10
+	DefaultExampleCount = 3
+)
+
+// EvaluationConfig limits the error pairs kept by Evaluate to the ErrorCount most frequent ones,
+// with up to ExampleCount example sentences each.
+type EvaluationConfig struct {
+	ErrorCount   int
+	ExampleCount int
+}
+
+type TagAccuracy struct {
+	Correct  int     `json:"correct"`
+	Total    int     `json:"total"`
+	Accuracy float64 `json:"accuracy"`
+}
+
+// ErrorExample holds the words of a test sentence and the position of the wrongly tagged word.
+type ErrorExample struct {
+	Words    []string `json:"words"`
+	Position int      `json:"position"`
+}
+
+type ErrorPair struct {
+	Expected  string         `json:"expected"`
+	Predicted string         `json:"predicted"`
+	Count     int            `json:"count"`
+	Examples  []ErrorExample `json:"examples"`
+}
+
+// Evaluation measures a tagger word by word, a word is unknown when it never appears in the
+// sentences the tagger learnt from.
+type Evaluation struct {
+	Accuracy        TagAccuracy              `json:"accuracy"`
+	Tags            []string                 `json:"tags"`
+	TagAccuracy     map[string]TagAccuracy   `json:"tag_accuracy"`
+	Known           TagAccuracy              `json:"known"`
+	Unknown         TagAccuracy              `json:"unknown"`
+	ConfusionMatrix *metrics.ConfusionMatrix `json:"confusion_matrix"`
+	Errors          []ErrorPair              `json:"errors"`
+}
+
+func (ta *TagAccuracy) add(correct bool) {
+	ta.Total++
+	if correct {
+		ta.Correct++
+	}
+	ta.Accuracy = float64(ta.Correct) / float64(ta.Total)
+}
+
+// Evaluate predicts every test sentence on its own, so the context of an n-gram tagger never
+// crosses the end of a sentence.
+func Evaluate(t Tagger, train [][][2]string, test [][][2]string, cfg EvaluationConfig) (*Evaluation, error) {
+	if cfg.ErrorCount == 0 {
+		cfg.ErrorCount = DefaultErrorCount
+	}
+
+	if cfg.ExampleCount == 0 {
+		cfg.ExampleCount = DefaultExampleCount
+	}
+
+	vocabulary := make(map[string]bool)
+	for _, sentence := range train {
+		for _, word := range sentence {
+			vocabulary[word[0]] = true
+		}
+	}
+
+	evaluation := Evaluation{
+		TagAccuracy: make(map[string]TagAccuracy),
+	}
+
+	errorIndex := make(map[[2]string]int)
+	var trueTags, predictedTags []string
+	for _, sentence := range test {
+		if len(sentence) == 0 {
+			continue
+		}
+
+		var words []string
+		for _, word := range sentence {
+			words = append(words, word[0])
+		}
+
+		predicted, err := t.Predict(strings.Join(words, " "))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(predicted) != len(sentence) {
+			return nil, errors.New(UnequalPredictLength)
+		}
+
+		for idx, word := range sentence {
+			expected := word[1]
+			predictedTag := predicted[idx][1]
+			correct := expected == predictedTag
+
+			trueTags = append(trueTags, expected)
+			predictedTags = append(predictedTags, predictedTag)
+
+			evaluation.Accuracy.add(correct)
+
+			tagAccuracy := evaluation.TagAccuracy[expected]
+			tagAccuracy.add(correct)
+			evaluation.TagAccuracy[expected] = tagAccuracy
+
+			if vocabulary[word[0]] {
+				evaluation.Known.add(correct)
+			} else {
+				evaluation.Unknown.add(correct)
+			}
+
+			if correct {
+				continue
+			}
+
+			pair := [2]string{expected, predictedTag}
+			if _, exists := errorIndex[pair]; !exists {
+				errorIndex[pair] = len(evaluation.Errors)
+				evaluation.Errors = append(evaluation.Errors, ErrorPair{
+					Expected:  expected,
+					Predicted: predictedTag,
+				})
+			}
+
+			errorPair := &evaluation.Errors[errorIndex[pair]]
+			errorPair.Count++
+			if len(errorPair.Examples) < cfg.ExampleCount {
+				errorPair.Examples = append(errorPair.Examples, ErrorExample{
+					Words:    words,
+					Position: idx,
+				})
+			}
+		}
+	}
+
+	if evaluation.Accuracy.Total == 0 {
+		return nil, errors.New(EmptyTestData)
+	}
+
+	for tag := range evaluation.TagAccuracy {
+		evaluation.Tags = append(evaluation.Tags, tag)
+	}
+	sort.Strings(evaluation.Tags)
+
+	confusionMatrix, err := metrics.NewConfusionMatrix(trueTags, predictedTags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation.ConfusionMatrix = confusionMatrix
+
+	sort.SliceStable(evaluation.Errors, func(i, j int) bool {
+		return evaluation.Errors[i].Count > evaluation.Errors[j].Count
+	})
+
+	if len(evaluation.Errors) > cfg.ErrorCount {
+		evaluation.Errors = evaluation.Errors[0:cfg.ErrorCount]
+	}
+
+	return &evaluation, nil
+}
+
+// String marks the wrongly tagged word of the example as [word].
+func (e ErrorExample) String() string {
+	var words []string
+	for idx, word := range e.Words {
+		if idx == e.Position {
+			word = "[" + word + "]"
+		}
+		words = append(words, word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func (e *Evaluation) String() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-10s %10s %10s\n", "", "accuracy", "total"))
+	builder.WriteString(fmt.Sprintf("%-10s %10.4f %10d\n", "overall", e.Accuracy.Accuracy, e.Accuracy.Total))
+	builder.WriteString(fmt.Sprintf("%-10s %10.4f %10d\n", "known", e.Known.Accuracy, e.Known.Total))
+	builder.WriteString(fmt.Sprintf("%-10s %10.4f %10d\n", "unknown", e.Unknown.Accuracy, e.Unknown.Total))
+	builder.WriteString("\n")
+
+	for _, tag := range e.Tags {
+		builder.WriteString(fmt.Sprintf("%-10s %10.4f %10d\n", tag, e.TagAccuracy[tag].Accuracy, e.TagAccuracy[tag].Total))
+	}
+	builder.WriteString("\n")
+
+	builder.WriteString(e.ConfusionMatrix.String())
+
+	for _, errorPair := range e.Errors {
+		builder.WriteString(fmt.Sprintf("\n%s -> %s (%d)\n", errorPair.Expected, errorPair.Predicted, errorPair.Count))
+		for _, example := range errorPair.Examples {
+			builder.WriteString("  " + example.String() + "\n")
+		}
+	}
+
+	return builder.String()
+}
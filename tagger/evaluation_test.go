@@ -0,0 +1,79 @@
+package tagger
+
+import (
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	train := [][][2]string{
+		{{"saya", "PRP"}, {"makan", "VB"}, {"nasi", "NN"}, {".", "."}},
+	}
+	test := [][][2]string{
+		{{"saya", "PRP"}, {"minum", "VB"}, {"nasi", "NN"}, {".", "."}},
+		{{"kamu", "PRP"}, {"makan", "VB"}, {".", "."}},
+	}
+
+	unigramTagger := NewUnigramTagger(UnigramTaggerConfig{
+		BackoffTagger: NewDefaultTagger(DefaultTaggerConfig{
+			DefaultTag: "nn",
+		}),
+	})
+
+	err := unigramTagger.Learn(train)
+
+	if err != nil {
+		panic(err)
+	}
+
+	evaluation, err := Evaluate(unigramTagger, train, test, EvaluationConfig{})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if evaluation.Accuracy.Correct != 5 || evaluation.Accuracy.Total != 7 {
+		t.Errorf("Tagger Should Tag 5 Of 7 Words Right, Got %+v", evaluation.Accuracy)
+	}
+
+	if evaluation.Known.Accuracy != 1 || evaluation.Known.Total != 5 {
+		t.Errorf("Every Known Word Should Be Tagged Right, Got %+v", evaluation.Known)
+	}
+
+	if evaluation.Unknown.Accuracy != 0 || evaluation.Unknown.Total != 2 {
+		t.Errorf("Every Unknown Word Should Be Tagged Wrong, Got %+v", evaluation.Unknown)
+	}
+
+	if evaluation.TagAccuracy["PRP"].Accuracy != 0.5 || evaluation.TagAccuracy["NN"].Accuracy != 1 {
+		t.Errorf("PRP Should Have Accuracy 0.5 And NN Accuracy 1, Got %+v", evaluation.TagAccuracy)
+	}
+
+	if evaluation.ConfusionMatrix.Get("VB", "NN") != 1 || evaluation.ConfusionMatrix.Get("PRP", "NN") != 1 {
+		t.Errorf("Confusion Matrix Should Count Every Mistake")
+	}
+
+	if len(evaluation.Errors) != 2 || evaluation.Errors[0].Expected != "VB" || evaluation.Errors[0].Predicted != "NN" {
+		t.Errorf("Errors Should Keep The Order Of Their First Mistake On A Tie, Got %+v", evaluation.Errors)
+	}
+
+	if evaluation.Errors[0].Examples[0].String() != "saya [minum] nasi ." {
+		t.Errorf("Example Should Mark The Wrong Word, Got %s", evaluation.Errors[0].Examples[0])
+	}
+
+	evaluation, err = Evaluate(unigramTagger, train, test, EvaluationConfig{
+		ErrorCount: 1,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	if len(evaluation.Errors) != 1 {
+		t.Errorf("Errors Should Be Limited To The Error Count, Got %d", len(evaluation.Errors))
+	}
+
+	_, err = Evaluate(unigramTagger, train, nil, EvaluationConfig{})
+
+	if err == nil || err.Error() != EmptyTestData {
+		t.Errorf("Evaluate Should Reject Empty Test Data")
+	}
+}
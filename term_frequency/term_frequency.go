@@ -9,6 +9,12 @@ type WordVectorizer interface {
 	Normalize(document string) (string, error)
 }
 
+// Tokenizer is implemented by a WordVectorizer that splits documents on its own, e.g. into
+// n-grams. Any other WordVectorizer has its documents split on a space.
+type Tokenizer interface {
+	Tokenize(document string) []string
+}
+
 type TermFrequency struct {
 	data           map[string][][]uint64 //[corpus_name][][]word
 	binary         bool
@@ -56,6 +62,25 @@ func (tf TermFrequency) Vectorize(corpusInput []string) ([][]uint64, error) {
 
 	return returnData, nil
 }
+func (tf TermFrequency) EvaluateInput(input []string) ([][]float64, error) {
+	vectorizedInput, err := tf.Vectorize(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var evaluatedInput [][]float64
+	for _, corpus := range vectorizedInput {
+		slice := make([]float64, len(corpus))
+		for idx, word := range corpus {
+			slice[idx] = float64(word)
+		}
+		evaluatedInput = append(evaluatedInput, slice)
+	}
+
+	return evaluatedInput, nil
+}
+
 func (tf *TermFrequency) Learn(corpuses map[string][]string) error {
 
 	for corpusClass, corpus := range corpuses {
@@ -74,6 +99,10 @@ func (tf *TermFrequency) countingWord(document string) []uint64 {
 	slice = make([]uint64, len(vectorizedWord))
 
 	tokenizeWords := strings.Split(document, " ")
+	if tokenizer, ok := tf.wordVectorizer.(Tokenizer); ok {
+		tokenizeWords = tokenizer.Tokenize(document)
+	}
+
 	for _, word := range tokenizeWords {
 		if _, exists := vectorizedWord[word]; exists {
 			if tf.binary {
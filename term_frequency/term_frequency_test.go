@@ -0,0 +1,62 @@
+package term_frequency
+
+import (
+	"strings"
+	"testing"
+)
+
+// spaceVectorizer only implements WordVectorizer, so its documents are split on a space.
+type spaceVectorizer struct {
+	dictionary map[string]uint64
+}
+
+func (sv spaceVectorizer) GetVectorizedWord() map[string]uint64 {
+	return sv.dictionary
+}
+
+func (sv spaceVectorizer) Normalize(document string) (string, error) {
+	return strings.ToLower(document), nil
+}
+
+// bigramVectorizer also implements Tokenizer and adds the bigrams of a document.
+type bigramVectorizer struct {
+	spaceVectorizer
+}
+
+func (bv bigramVectorizer) Tokenize(document string) []string {
+	words := strings.Split(document, " ")
+	tokens := append([]string{}, words...)
+	for idx := 0; idx+1 < len(words); idx++ {
+		tokens = append(tokens, words[idx]+" "+words[idx+1])
+	}
+	return tokens
+}
+
+func TestVectorize(t *testing.T) {
+	dictionary := map[string]uint64{"beli": 0, "pulsa": 1, "beli pulsa": 2}
+
+	for name, testCase := range map[string]struct {
+		wordVectorizer WordVectorizer
+		expected       []uint64
+	}{
+		"Space":  {spaceVectorizer{dictionary}, []uint64{2, 1, 0}},
+		"Bigram": {bigramVectorizer{spaceVectorizer{dictionary}}, []uint64{2, 1, 1}},
+	} {
+		tf := New(TermFrequencyConfig{
+			WordVectorizer: testCase.wordVectorizer,
+		})
+
+		vectorized, err := tf.Vectorize([]string{"Beli pulsa beli"})
+
+		if err != nil {
+			panic(err)
+		}
+
+		for idx := range testCase.expected {
+			if vectorized[0][idx] != testCase.expected[idx] {
+				t.Errorf("%s Vectorizer Should Count %v, Got %v", name, testCase.expected, vectorized[0])
+				break
+			}
+		}
+	}
+}
@@ -169,12 +169,10 @@ func (tfidf TermFrequencyInverseDocumentFrequency) GetTrainedData() map[string][
 	return tfidf.data
 }
 
-func (tfidf TermFrequencyInverseDocumentFrequency) EvaluateInput(input interface{}) ([][]float64, error) {
+func (tfidf TermFrequencyInverseDocumentFrequency) EvaluateInput(input []string) ([][]float64, error) {
 	var evaluatedInput [][]float64
 
-	convertedInput := input.([]string)
-
-	vectorizedInput, err := tfidf.countVectorizer.Vectorize(convertedInput)
+	vectorizedInput, err := tfidf.countVectorizer.Vectorize(input)
 
 	if err != nil {
 		return nil, err
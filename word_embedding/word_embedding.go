@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/adrian3ka/go-learn-ai/helper"
+	"github.com/adrian3ka/go-learn-ai/layer"
 	"math/rand"
 	"time"
 )
@@ -21,16 +22,10 @@ const (
 	GradientDescentOptimizer OptimizerType          = "gradient_descent_optimizer"
 )
 
-type Layer struct {
-	Value  [][]float64
-	Weight [][]float64
-	Bias   float64
-}
-
 type WordEmbedding struct {
 	dimension          uint64
-	hiddenLayer        Layer
-	outputLayer        Layer
+	hiddenLayer        layer.Layer
+	outputLayer        layer.Layer
 	lossFunction       LossFunctionType
 	activationFunction ActivationFunctionType
 	optimizer          OptimizerType
@@ -60,25 +55,18 @@ func (we *WordEmbedding) Learn(source, target [][]float64) error {
 		var tempSource, tempTarget [][]float64
 		tempSource = append(tempSource, source[idx])
 		tempTarget = append(tempTarget, target[idx])
-		tempMatrix1, err := helper.MatrixMultiplication(tempSource, we.hiddenLayer.Weight)
+		_, err := we.hiddenLayer.Forward(tempSource)
 
 		if err != nil {
 			return err
 		}
 
-		we.hiddenLayer.Value = helper.MatrixAdditionWithNumber(tempMatrix1, we.hiddenLayer.Bias)
-
-		tempMatrix2, err := helper.MatrixMultiplication(we.hiddenLayer.Value, we.outputLayer.Weight)
+		tempMatrix2, err := we.outputLayer.Forward(we.hiddenLayer.Value)
 
 		if err != nil {
 			return err
 		}
 
-		tempMatrix2 = helper.MatrixAdditionWithNumber(tempMatrix2, we.outputLayer.Bias)
-
-		if we.activationFunction == Softmax {
-			tempMatrix2[0] = helper.Softmax(tempMatrix2[0])
-		}
 		fmt.Println(tempMatrix2)
 	}
 
@@ -99,8 +87,9 @@ func NewWordEmbedding(config WordEmbeddingConfig) (*WordEmbedding, error) {
 		return nil, errors.New(OneHotEncodedNil)
 	}
 
-	hiddenLayer := Layer{
-		Bias: helper.RandFloat(0, 1),
+	hiddenLayer := layer.Layer{
+		Bias:       helper.RandFloats(0, 1, int(config.Dimension)),
+		Activation: layer.Linear,
 	}
 
 	for i := 0; i < len(config.OneHotEncodedData.GetEncodedData()[0][0]); i++ {
@@ -108,8 +97,13 @@ func NewWordEmbedding(config WordEmbeddingConfig) (*WordEmbedding, error) {
 		hiddenLayer.Weight = append(hiddenLayer.Weight, tempRow)
 	}
 
-	outputLayer := Layer{
-		Bias: helper.RandFloat(0, 1),
+	outputLayer := layer.Layer{
+		Bias:       helper.RandFloats(0, 1, len(config.OneHotEncodedData.GetEncodedData()[0][0])),
+		Activation: layer.Linear,
+	}
+
+	if config.ActivationFunction == Softmax {
+		outputLayer.Activation = layer.Softmax
 	}
 
 	for i := 0; i < int(config.Dimension); i++ {
@@ -12,18 +12,23 @@ type RegexReplacer struct {
 
 type WordVectorizer struct {
 	lower           bool
+	nGram           uint64
 	data            map[string]uint64 //[word]index
 	cleanedCorpuses map[string][]string
 	regexReplacers  []RegexReplacer
 }
 
+// WordVectorizerConfig adds every sequence of up to NGram words to the dictionary, only single
+// words are used when it is zero or one.
 type WordVectorizerConfig struct {
 	Lower bool
+	NGram uint64
 }
 
 func New(vectorizer WordVectorizerConfig) WordVectorizer {
 	wv := WordVectorizer{
 		lower: vectorizer.Lower,
+		nGram: vectorizer.NGram,
 		regexReplacers: []RegexReplacer{
 			{Pattern: `[^a-zA-Z0-9 ]+`, Replacer: ``},
 			{Pattern: `\s+`, Replacer: ` `},
@@ -46,8 +51,7 @@ func (wv *WordVectorizer) Learn(corpuses map[string][]string) error {
 				return err
 			}
 
-			tokenizeWords := strings.Split(cleanedDocument, " ")
-			for _, word := range tokenizeWords {
+			for _, word := range wv.Tokenize(cleanedDocument) {
 				if _, exists := wv.data[word]; !exists {
 					wv.data[word] = uint64(len(wv.data))
 				}
@@ -76,6 +80,31 @@ func (wv WordVectorizer) Normalize(document string) (string, error) {
 	return document, nil
 }
 
+// Tokenize splits a normalized document into its words followed by the n-grams of the words,
+// an n-gram is its words joined by a space.
+func (wv WordVectorizer) Tokenize(document string) []string {
+	tokens := strings.Split(document, " ")
+
+	if wv.nGram <= 1 {
+		return tokens
+	}
+
+	var words []string
+	for _, word := range tokens {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	for n := 2; n <= int(wv.nGram); n++ {
+		for idx := 0; idx+n <= len(words); idx++ {
+			tokens = append(tokens, strings.Join(words[idx:idx+n], " "))
+		}
+	}
+
+	return tokens
+}
+
 func (wv WordVectorizer) GetVectorizedWord() map[string]uint64 {
 	return wv.data
 }
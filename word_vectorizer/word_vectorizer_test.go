@@ -73,3 +73,28 @@ func TestBasic(t *testing.T) {
 		t.Errorf("Saldo Document Count Should Be Still %d", SaldoDocumentCount)
 	}
 }
+
+func TestNGram(t *testing.T) {
+	wordVectorizer := New(WordVectorizerConfig{
+		Lower: true,
+		NGram: 2,
+	})
+
+	err := wordVectorizer.Learn(map[string][]string{
+		Pulsa: {"Beli pulsa dong", "beli  voucher"},
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	for _, word := range []string{"beli", "pulsa", "beli pulsa", "pulsa dong", "beli voucher"} {
+		if _, exists := wordVectorizer.GetVectorizedWord()[word]; !exists {
+			t.Errorf("Dictionary Should Contain %s", word)
+		}
+	}
+
+	if len(wordVectorizer.GetVectorizedWord()) != 7 {
+		t.Errorf("Vectorized Word Length Should Be 7, Got %d", len(wordVectorizer.GetVectorizedWord()))
+	}
+}